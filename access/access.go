@@ -0,0 +1,242 @@
+package access
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"abi/api"
+	"abi/datekey"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Read access modes, in the order of the contract's AccessMode enum
+const (
+	Allowlisted uint8 = iota
+	Public
+	AllowlistedWithExpiry
+)
+
+// Names accepted by ParseMode, indexed by mode
+var ModeNames = []string{"allowlisted", "public", "expiring"}
+
+// Node access needed to send grants
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Consumer that should be able to read, until Expires when it is set
+type Grant struct {
+	Account common.Address
+	Expires time.Time
+}
+
+// Expiry as stored by the contract, 0 for no expiry
+func (g Grant) Expiry() *big.Int {
+	if g.Expires.IsZero() {
+		return new(big.Int)
+	}
+	return big.NewInt(g.Expires.Unix())
+}
+
+// Mode number for one of ModeNames
+func ParseMode(name string) (uint8, error) {
+	for mode, candidate := range ModeNames {
+		if strings.EqualFold(name, candidate) {
+			return uint8(mode), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown access mode %q (want one of %s)", name, strings.Join(ModeNames, ", "))
+}
+
+// Name of a mode read from the contract
+func ModeName(mode uint8) string {
+	if int(mode) < len(ModeNames) {
+		return ModeNames[mode]
+	}
+	return fmt.Sprintf("unknown (%d)", mode)
+}
+
+// Reads grants from a CSV file with an address column and an optional expiry
+// column. An expiry is either a day (dd/mm/yyyy), meaning access lasts through
+// that day in UTC, or an RFC 3339 time; an empty one never expires. A header row
+// starting with "address" and lines starting with # are ignored.
+func ParseCSV(r io.Reader) ([]Grant, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var grants []Grant
+	seen := make(map[common.Address]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if len(grants) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) > 2 {
+			return nil, fmt.Errorf("line %d: expected address and expiry, got %d fields", line, len(record))
+		}
+
+		value := strings.TrimSpace(record[0])
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("line %d: invalid address %q", line, value)
+		}
+		grant := Grant{Account: common.HexToAddress(value)}
+		if len(record) == 2 {
+			if grant.Expires, err = parseExpiry(strings.TrimSpace(record[1])); err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+		}
+		if previous, ok := seen[grant.Account]; ok {
+			return nil, fmt.Errorf("line %d: %s already listed on line %d", line, grant.Account.Hex(), previous)
+		}
+		seen[grant.Account] = line
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// Reads a file in the format of ParseCSV
+func LoadCSV(path string) ([]Grant, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	grants, err := ParseCSV(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return grants, nil
+}
+
+func parseExpiry(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if key, err := datekey.Parse(value); err == nil {
+		return key.Next().Time(), nil
+	}
+	expires, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %q (want dd/mm/yyyy or RFC 3339)", value)
+	}
+	return expires, nil
+}
+
+// Drops grants the contract already holds with the same expiry, so a file
+// can be applied again after editing or an interruption
+func Pending(ctx context.Context, oracle *api.OracleIndicatorCaller, grants []Grant) ([]Grant, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	role, err := oracle.READONLY(opts)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Grant
+	for _, grant := range grants {
+		has, err := oracle.HasRole(opts, role, grant.Account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", grant.Account.Hex(), err)
+		}
+		expiry, err := oracle.ReadExpiry(opts, grant.Account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", grant.Account.Hex(), err)
+		}
+		if !has || expiry.Cmp(grant.Expiry()) != 0 {
+			pending = append(pending, grant)
+		}
+	}
+	return pending, nil
+}
+
+// Accounts among the grants that currently hold READ_ONLY
+func Holders(ctx context.Context, oracle *api.OracleIndicatorCaller, grants []Grant) ([]Grant, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	role, err := oracle.READONLY(opts)
+	if err != nil {
+		return nil, err
+	}
+	var holders []Grant
+	for _, grant := range grants {
+		has, err := oracle.HasRole(opts, role, grant.Account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", grant.Account.Hex(), err)
+		}
+		if has {
+			holders = append(holders, grant)
+		}
+	}
+	return holders, nil
+}
+
+// Grants read access to batchSize accounts per transaction through grantReadAccess.
+// Returns how many accounts were granted before the first failure.
+func Apply(ctx context.Context, backend Backend, auth *bind.TransactOpts, oracle *api.OracleIndicatorTransactor, grants []Grant, batchSize int) (int, error) {
+	return send(ctx, backend, grants, batchSize, func(batch []Grant) (*types.Transaction, error) {
+		accounts := make([]common.Address, len(batch))
+		expiries := make([]*big.Int, len(batch))
+		for i, grant := range batch {
+			accounts[i] = grant.Account
+			expiries[i] = grant.Expiry()
+		}
+		opts := *auth
+		opts.Context = ctx
+		return oracle.GrantReadAccess(&opts, accounts, expiries)
+	})
+}
+
+// Revokes read access batchSize accounts per transaction through revokeReadAccess.
+// Returns how many accounts were revoked before the first failure.
+func Revoke(ctx context.Context, backend Backend, auth *bind.TransactOpts, oracle *api.OracleIndicatorTransactor, grants []Grant, batchSize int) (int, error) {
+	return send(ctx, backend, grants, batchSize, func(batch []Grant) (*types.Transaction, error) {
+		accounts := make([]common.Address, len(batch))
+		for i, grant := range batch {
+			accounts[i] = grant.Account
+		}
+		opts := *auth
+		opts.Context = ctx
+		return oracle.RevokeReadAccess(&opts, accounts)
+	})
+}
+
+// Sends one transaction per batch and waits for it before sending the next
+func send(ctx context.Context, backend Backend, grants []Grant, batchSize int, transact func([]Grant) (*types.Transaction, error)) (int, error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	done := 0
+	for start := 0; start < len(grants); start += batchSize {
+		batch := grants[start:min(start+batchSize, len(grants))]
+		tx, err := transact(batch)
+		if err != nil {
+			return done, fmt.Errorf("failed to send batch starting at %s: %w", batch[0].Account.Hex(), api.DecodeError(err))
+		}
+		receipt, err := bind.WaitMined(ctx, backend, tx)
+		if err != nil {
+			return done, fmt.Errorf("waiting for %s: %v", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return done, fmt.Errorf("%s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, backend, tx, receipt))
+		}
+		done += len(batch)
+	}
+	return done, nil
+}
@@ -0,0 +1,189 @@
+package access_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"abi/access"
+	"abi/api"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestParseCSV(t *testing.T) {
+	input := `address,expiry
+# pilot consumers
+0x1111111111111111111111111111111111111111,
+0x2222222222222222222222222222222222222222,31/12/2024
+0x3333333333333333333333333333333333333333, 2025-06-30T12:00:00-03:00
+0x4444444444444444444444444444444444444444
+`
+	grants, err := access.ParseCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		account string
+		expiry  int64
+	}{
+		{"0x1111111111111111111111111111111111111111", 0},
+		{"0x2222222222222222222222222222222222222222", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Unix()},
+		{"0x3333333333333333333333333333333333333333", time.Date(2025, 6, 30, 15, 0, 0, 0, time.UTC).Unix()},
+		{"0x4444444444444444444444444444444444444444", 0},
+	}
+	if len(grants) != len(want) {
+		t.Fatalf("parsed %d grants, want %d", len(grants), len(want))
+	}
+	for i, w := range want {
+		if grants[i].Account != common.HexToAddress(w.account) || grants[i].Expiry().Int64() != w.expiry {
+			t.Errorf("grant %d = %s %v, want %s %d", i, grants[i].Account.Hex(), grants[i].Expiry(), w.account, w.expiry)
+		}
+	}
+
+	for name, input := range map[string]string{
+		"bad address": "0x12,\n",
+		"bad expiry":  "0x1111111111111111111111111111111111111111,tomorrow\n",
+		"duplicate":   "0x1111111111111111111111111111111111111111\n0x1111111111111111111111111111111111111111,31/12/2024\n",
+		"extra field": "0x1111111111111111111111111111111111111111,31/12/2024,x\n",
+	} {
+		if _, err := access.ParseCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+
+	if _, err := access.ParseMode("Expiring"); err != nil {
+		t.Error(err)
+	}
+	if _, err := access.ParseMode("open"); err == nil {
+		t.Error("unknown mode accepted")
+	}
+}
+
+func TestReadAccessModes(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.New(t, "CDI", 8)
+	tx, err := chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(1704153600), big.NewInt(1e8), big.NewInt(1704153600), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	_, permanent := chain.NewAccount(t)
+	_, expired := chain.NewAccount(t)
+	_, later := chain.NewAccount(t)
+	_, outsider := chain.NewAccount(t)
+	chain.AutoMine(t, 20*time.Millisecond)
+
+	grants := []access.Grant{
+		{Account: permanent.From},
+		{Account: expired.From, Expires: time.Unix(1, 0)},
+		{Account: later.From, Expires: time.Now().Add(24 * time.Hour)},
+	}
+	done, err := access.Apply(ctx, chain.Client, chain.Admin, &chain.Oracle.OracleIndicatorTransactor, grants, 2)
+	if err != nil || done != 3 {
+		t.Fatalf("apply = %d, %v", done, err)
+	}
+	if pending, err := access.Pending(ctx, &chain.Oracle.OracleIndicatorCaller, grants); err != nil || len(pending) != 0 {
+		t.Fatalf("pending after apply = %v, %v", pending, err)
+	}
+
+	read := func(account common.Address) error {
+		_, err := chain.Oracle.GetLast(chain.CallOpts(account))
+		return err
+	}
+	canRead := func(account common.Address) bool {
+		can, err := chain.Oracle.CanRead(chain.CallOpts(account), account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return can
+	}
+	setMode := func(mode uint8) {
+		tx, err := chain.Oracle.SetAccessMode(chain.Admin, mode)
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+	}
+
+	// allowlisted, the default, ignores expiries
+	for _, account := range []common.Address{permanent.From, expired.From, later.From} {
+		if err := read(account); err != nil || !canRead(account) {
+			t.Fatalf("allowlisted read by %s: %v", account.Hex(), api.DecodeError(err))
+		}
+	}
+	simtest.RequireRevert(t, read(outsider.From), "AccessControlUnauthorizedAccount")
+
+	// the stored days are behind the same check, except for the operator roles
+	_, err = chain.Oracle.GetDay(chain.CallOpts(outsider.From), big.NewInt(1704153600))
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	if day, err := chain.Oracle.GetDay(chain.CallOpts(chain.Admin.From), big.NewInt(1704153600)); err != nil || day.Feed.Value.Int64() != 1e8 {
+		t.Fatalf("operator getDay = %+v, %v", day, api.DecodeError(err))
+	}
+
+	setMode(access.AllowlistedWithExpiry)
+	revert := simtest.RequireRevert(t, read(expired.From), "ReadAccessExpired")
+	if revert.Args[0] != expired.From {
+		t.Errorf("revert names %v", revert.Args[0])
+	}
+	if canRead(expired.From) {
+		t.Error("canRead true for an expired grant")
+	}
+	for _, account := range []common.Address{permanent.From, later.From} {
+		if err := read(account); err != nil || !canRead(account) {
+			t.Fatalf("expiring read by %s: %v", account.Hex(), api.DecodeError(err))
+		}
+	}
+
+	setMode(access.Public)
+	if err := read(outsider.From); err != nil || !canRead(outsider.From) {
+		t.Fatalf("public read: %v", api.DecodeError(err))
+	}
+
+	// an outsider cannot change the mode
+	_, err = chain.Oracle.SetAccessMode(outsider, access.Allowlisted)
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+
+	setMode(access.Allowlisted)
+	holders, err := access.Holders(ctx, &chain.Oracle.OracleIndicatorCaller, append(grants, access.Grant{Account: outsider.From}))
+	if err != nil || len(holders) != 3 {
+		t.Fatalf("holders = %v, %v", holders, err)
+	}
+	done, err = access.Revoke(ctx, chain.Client, chain.Admin, &chain.Oracle.OracleIndicatorTransactor, holders, 10)
+	if err != nil || done != 3 {
+		t.Fatalf("revoke = %d, %v", done, err)
+	}
+	if canRead(permanent.From) {
+		t.Error("canRead true after revoke")
+	}
+	if expiry, err := chain.Oracle.ReadExpiry(chain.CallOpts(chain.Admin.From), later.From); err != nil || expiry.Sign() != 0 {
+		t.Errorf("expiry after revoke = %v, %v", expiry, err)
+	}
+
+	// a plain revokeRole clears the expiry too, so a later grantRole does not revive it
+	if _, err := access.Apply(ctx, chain.Client, chain.Admin, &chain.Oracle.OracleIndicatorTransactor, grants[1:2], 1); err != nil {
+		t.Fatal(err)
+	}
+	role, err := chain.Oracle.READONLY(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range []func() (*types.Transaction, error){
+		func() (*types.Transaction, error) { return chain.Oracle.RevokeRole(chain.Admin, role, expired.From) },
+		func() (*types.Transaction, error) { return chain.Oracle.GrantRole(chain.Admin, role, expired.From) },
+	} {
+		tx, err := change()
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+	}
+	if expiry, err := chain.Oracle.ReadExpiry(chain.CallOpts(chain.Admin.From), expired.From); err != nil || expiry.Sign() != 0 {
+		t.Errorf("expiry after revokeRole and grantRole = %v, %v", expiry, err)
+	}
+}
@@ -0,0 +1,103 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Alert statuses
+const (
+	Firing   = "firing"
+	Resolved = "resolved"
+)
+
+// Payload posted to the webhook
+type Alert struct {
+	Status  string    `json:"status"`
+	Check   string    `json:"check"`
+	Network string    `json:"network"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// Posts alerts as JSON to a generic webhook URL. Each check only fires once
+// until it recovers, when a resolved alert is sent.
+type Webhook struct {
+	URL    string
+	Client *http.Client
+
+	mu     sync.Mutex
+	firing map[string]bool
+}
+
+func NewWebhook(url string) *Webhook {
+	return &Webhook{URL: url, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Reports the result of a check, sending an alert only when its state changes
+func (w *Webhook) Report(ctx context.Context, network, check string, failure error) error {
+	key := network + "/" + check
+
+	w.mu.Lock()
+	wasFiring := w.firing[key]
+	w.mu.Unlock()
+
+	var err error
+	switch {
+	case failure != nil && !wasFiring:
+		err = w.Send(ctx, Alert{Status: Firing, Check: check, Network: network, Message: failure.Error(), Time: time.Now().UTC()})
+	case failure == nil && wasFiring:
+		err = w.Send(ctx, Alert{Status: Resolved, Check: check, Network: network, Message: check + " recovered", Time: time.Now().UTC()})
+	default:
+		return nil
+	}
+	// the state only moves once the alert is delivered, so a failed
+	// delivery is retried on the next report
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	if w.firing == nil {
+		w.firing = make(map[string]bool)
+	}
+	w.firing[key] = failure != nil
+	w.mu.Unlock()
+	return nil
+}
+
+// Posts a single alert
+func (w *Webhook) Send(ctx context.Context, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s returned %s", w.URL, resp.Status)
+	}
+	return nil
+}
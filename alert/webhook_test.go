@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestReportSendsOnStateChange(t *testing.T) {
+	var mu sync.Mutex
+	var received []Alert
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a Alert
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			t.Errorf("invalid payload: %v", err)
+		}
+		mu.Lock()
+		received = append(received, a)
+		mu.Unlock()
+	}))
+	defer stub.Close()
+
+	hook := NewWebhook(stub.URL)
+	ctx := context.Background()
+	stale := errors.New("last value is 3 days old")
+
+	steps := []error{nil, stale, stale, nil, nil}
+	for _, failure := range steps {
+		if err := hook.Report(ctx, "hardhat", "staleness", failure); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(received) != 2 {
+		t.Fatalf("got %d alerts, want 2: %+v", len(received), received)
+	}
+	if received[0].Status != Firing || received[0].Message != stale.Error() || received[0].Network != "hardhat" {
+		t.Errorf("unexpected firing alert %+v", received[0])
+	}
+	if received[1].Status != Resolved || received[1].Check != "staleness" {
+		t.Errorf("unexpected resolved alert %+v", received[1])
+	}
+}
+
+func TestSendFailsOnErrorStatus(t *testing.T) {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer stub.Close()
+
+	if err := NewWebhook(stub.URL).Send(context.Background(), Alert{Status: Firing}); err == nil {
+		t.Fatal("expected an error for a 502 response")
+	}
+}
+
+func TestReportRetriesFailedDelivery(t *testing.T) {
+	var mu sync.Mutex
+	var statuses []int
+	fail := true
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusBadGateway)
+			statuses = append(statuses, http.StatusBadGateway)
+			return
+		}
+		statuses = append(statuses, http.StatusOK)
+	}))
+	defer stub.Close()
+
+	hook := NewWebhook(stub.URL)
+	ctx := context.Background()
+	stale := errors.New("last value is 3 days old")
+	if err := hook.Report(ctx, "hardhat", "staleness", stale); err == nil {
+		t.Fatal("expected the failed delivery to be reported")
+	}
+	if err := hook.Report(ctx, "hardhat", "staleness", stale); err != nil {
+		t.Fatal(err)
+	}
+	if err := hook.Report(ctx, "hardhat", "staleness", stale); err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 || statuses[1] != http.StatusOK {
+		t.Fatalf("deliveries %v, want the firing alert retried once", statuses)
+	}
+}
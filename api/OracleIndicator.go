@@ -37,113 +37,135 @@ type OracleIndicatorDataFeed struct {
 	Confidence uint8
 }
 
-// ApiMetaData contains all meta data concerning the Api contract.
-var ApiMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"_defaultAdmin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessControlBadConfirmation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}],\"name\":\"AccessControlUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MathOverflowedMulDiv\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"READ_ONLY\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimal\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"getDate\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicator.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLast\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicator.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getName\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"indicators\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"}],\"name\":\"saveIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+// OracleIndicatorMetaData contains all meta data concerning the OracleIndicator contract.
+var OracleIndicatorMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"_defaultAdmin\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessControlBadConfirmation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}],\"name\":\"AccessControlUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"reporter\",\"type\":\"address\"}],\"name\":\"AlreadySubmitted\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ConsensusDisabled\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ConsensusEnabled\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"DayAlreadyFinalized\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"last\",\"type\":\"int256\"},{\"internalType\":\"uint16\",\"name\":\"maxDeviationBps\",\"type\":\"uint16\"}],\"name\":\"DeviationTooLarge\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EnforcedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ExpectedPause\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"IndicatorNotFound\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"IndicatorRetracted\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"quorum\",\"type\":\"uint8\"},{\"internalType\":\"uint16\",\"name\":\"toleranceBps\",\"type\":\"uint16\"}],\"name\":\"InvalidConsensus\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"minValue\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"maxValue\",\"type\":\"int256\"}],\"name\":\"InvalidLimits\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidShortString\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"accounts\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiries\",\"type\":\"uint256\"}],\"name\":\"LengthMismatch\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MathOverflowedMulDiv\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expiresAt\",\"type\":\"uint256\"}],\"name\":\"ReadAccessExpired\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"digest\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"ReportAlreadySubmitted\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"ReportExpired\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"}],\"name\":\"StaleReport\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"str\",\"type\":\"string\"}],\"name\":\"StringTooLong\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"UnauthorizedReporter\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"minValue\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"maxValue\",\"type\":\"int256\"}],\"name\":\"ValueOutOfBounds\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"enumOracleIndicator.AccessMode\",\"name\":\"mode\",\"type\":\"uint8\"}],\"name\":\"AccessModeChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"quorum\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"toleranceBps\",\"type\":\"uint16\"}],\"name\":\"ConsensusChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"EIP712DomainChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"IndicatorInvalidated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"IndicatorRestored\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"minValue\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"maxValue\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"maxDeviationBps\",\"type\":\"uint16\"}],\"name\":\"LimitsChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"LimitsOverridden\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expiresAt\",\"type\":\"uint256\"}],\"name\":\"ReadAccessGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"ReadAccessRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GUARDIAN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_QUORUM\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"PUBLISHER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"READ_ONLY\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REPORTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REPORT_TYPEHASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"accessMode\",\"outputs\":[{\"internalType\":\"enumOracleIndicator.AccessMode\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"canRead\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"checkpointCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"consensusRound\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"submissions\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"finalized\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimal\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deviationBase\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"eip712Domain\",\"outputs\":[{\"internalType\":\"bytes1\",\"name\":\"fields\",\"type\":\"bytes1\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"chainId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"verifyingContract\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"internalType\":\"uint256[]\",\"name\":\"extensions\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getCumulativeInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"getDate\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicator.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"getDay\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicator.DataFeed\",\"name\":\"feed\",\"type\":\"tuple\"},{\"internalType\":\"bool\",\"name\":\"isRetracted\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLast\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicator.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getName\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_expiries\",\"type\":\"uint256[]\"}],\"name\":\"grantReadAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_reporter\",\"type\":\"address\"}],\"name\":\"hasSubmitted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"invalidate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastDay\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxDeviationBps\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxValue\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"minValue\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"}],\"name\":\"overrideIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"quorum\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"readExpiry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"}],\"name\":\"reportDigest\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"restore\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"retracted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"retractedCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_accounts\",\"type\":\"address[]\"}],\"name\":\"revokeReadAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"}],\"name\":\"saveIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumOracleIndicator.AccessMode\",\"name\":\"_mode\",\"type\":\"uint8\"}],\"name\":\"setAccessMode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"_quorum\",\"type\":\"uint8\"},{\"internalType\":\"uint16\",\"name\":\"_toleranceBps\",\"type\":\"uint16\"}],\"name\":\"setConsensus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"_min\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"_max\",\"type\":\"int256\"},{\"internalType\":\"uint16\",\"name\":\"_maxDeviationBps\",\"type\":\"uint16\"}],\"name\":\"setLimits\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_signature\",\"type\":\"bytes\"}],\"name\":\"submitSignedIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"}],\"name\":\"submitValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"submittedReports\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"toleranceBps\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x61016060405234801562000011575f80fd5b506040516200370638038062003706833981016040819052620000349162000354565b604080518082018252600f81526e27b930b1b632a4b73234b1b0ba37b960891b60208083019190915282518084019093526001808452603160f81b91840191909152805460ff19169055906200008c826002620001c1565b610120526200009d816003620001c1565b61014052815160208084019190912060e052815190820120610100524660a0526200012a60e05161010051604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201529081019290925260608201524660808201523060a08201525f9060c00160405160208183030381529060405280519060200120905090565b60805250503060c0526004805460ff191660ff84161790556005620001508482620004b9565b506200015d5f82620001f9565b506200018a7f0ac90c257048ef1c3e387c26d4a99bde06894efbcbff862dc1885c3a9319308a82620001f9565b50620001b77f55435dd261a4b9b3364963f7738a7a662ad9c84396d64be3365284bb7f0a504182620001f9565b50505050620005d9565b5f602083511015620001e057620001d883620002a4565b9050620001f3565b81620001ed8482620004b9565b5060ff90505b92915050565b5f828152602081815260408083206001600160a01b038516845290915281205460ff166200029c575f838152602081815260408083206001600160a01b03861684529091529020805460ff19166001179055620002533390565b6001600160a01b0316826001600160a01b0316847f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a4506001620001f3565b505f620001f3565b5f80829050601f81511115620002da578260405163305a27a960e01b8152600401620002d1919062000581565b60405180910390fd5b8051620002e782620005b5565b179392505050565b634e487b7160e01b5f52604160045260245ffd5b5f5b838110156200031f57818101518382015260200162000305565b50505f910152565b805160ff8116811462000338575f80fd5b919050565b80516001600160a01b038116811462000338575f80fd5b5f805f6060848603121562000367575f80fd5b83516001600160401b03808211156200037e575f80fd5b818601915086601f83011262000392575f80fd5b815181811115620003a757620003a7620002ef565b604051601f8201601f19908116603f01168101908382118183101715620003d257620003d2620002ef565b81604052828152896020848701011115620003eb575f80fd5b620003fe83602083016020880162000303565b8097505050505050620004146020850162000327565b915062000424604085016200033d565b90509250925092565b600181811c908216806200044257607f821691505b6020821081036200046157634e487b7160e01b5f52602260045260245ffd5b50919050565b601f821115620004b4575f81815260208120601f850160051c810160208610156200048f5750805b601f850160051c820191505b81811015620004b0578281556001016200049b565b5050505b505050565b81516001600160401b03811115620004d557620004d5620002ef565b620004ed81620004e684546200042d565b8462000467565b602080601f83116001811462000523575f84156200050b5750858301515b5f19600386901b1c1916600185901b178555620004b0565b5f85815260208120601f198616915b82811015620005535788860151825594840194600190910190840162000532565b50858210156200057157878501515f19600388901b60f8161c191681555b5050505050600190811b01905550565b602081525f8251806020840152620005a181604085016020870162000303565b601f01601f19169190910160400192915050565b8051602080830151919081101562000461575f1960209190910360031b1b16919050565b60805160a05160c05160e0516101005161012051610140516130db6200062b5f395f6120fa01525f6120c801525f6128ea01525f6128c201525f61281d01525f61284701525f61287101526130db5ff3fe608060405234801561000f575f80fd5b50600436106102ff575f3560e01c80636b0c932d116101955780639bea62ad116100e4578063d1607cdb1161009e578063d5c2d6fd11610079578063d5c2d6fd14610705578063d8f4b6fd14610718578063f2ac3f081461073f578063fac6297214610752575f80fd5b8063d1607cdb146106e2578063d2cbc867146106ea578063d547741f146106f2575f80fd5b80639bea62ad1461066d5780639fa2c77614610680578063a217fddf146106a2578063a57d3806146106a9578063bf48027c146106bc578063cd64f6fb146106cf575f80fd5b80638456cb591161014f57806391d148541161012a57806391d148541461063557806394a5c2e414610648578063963e63c7146106515780639b824d0c1461065a575f80fd5b80638456cb59146105ff57806384b0196e146106075780638fd92eab14610622575f80fd5b80636b0c932d1461059857806376809ce3146105a157806376aad3fb146105ac57806377c6e440146105bf5780637b5c6e28146105d25780637e31d2cc146105ec575f80fd5b806336568abe1161025157806342087d4f1161020b57806355cc207b116101e657806355cc207b1461053a5780635780f841146105595780635c975abb1461056c57806365c7284014610577575f80fd5b806342087d4f146104f85780634a882fc31461050b5780634d62283114610532575f80fd5b806336568abe1461045557806339d80c27146104685780633ca956d81461047b5780633ee7a701146104a85780633f4ba83a146104c95780633f60d799146104d1575f80fd5b80631f618cd2116102bc5780632b57298b116102975780632b57298b146103e75780632c0af1ce146104075780632f2ff15d1461042f5780633488ecb314610442575f80fd5b80631f618cd2146103a9578063248a9ca3146103b157806324ea54f4146103d3575f80fd5b806301ffc9a7146103035780630e5fa7f11461032b57806315eecf211461034c5780631703a0181461036057806317d7de7c1461037f5780631ea1afdb14610394575b5f80fd5b61031661031136600461299e565b61075a565b60405190151581526020015b60405180910390f35b61033e6103393660046129c5565b610790565b604051908152602001610322565b61033e5f8051602061306683398151915281565b600e5461036d9060ff1681565b60405160ff9091168152602001610322565b6103876109bd565b6040516103229190612a28565b6103a76103a2366004612a3a565b610a4d565b005b600c5461033e565b61033e6103bf366004612a3a565b5f9081526020819052604090206001015490565b61033e5f8051602061308683398151915281565b6103fa6103f5366004612a3a565b610b55565b6040516103229190612a51565b61041a610415366004612a3a565b610bfa565b60408051928352901515602083015201610322565b6103a761043d366004612a9c565b610c3d565b61033e6104503660046129c5565b610c67565b6103a7610463366004612a9c565b610d16565b6103a7610476366004612b0e565b610d4e565b610316610489366004612a9c565b600d60209081525f928352604080842090915290825290205460ff1681565b6016546104b69061ffff1681565b60405161ffff9091168152602001610322565b6103a7610ec1565b61033e7f3204c940063673962b481a0395619b3dbbd137589c419e993978c1c71bcf68ec81565b610316610506366004612b75565b610ee3565b61033e7f5afc4ec52f9af4394c52f4d1fc6eaff0cea665d5cc56d61e00ea0a9ad47456ba81565b6103fa610f77565b61033e610548366004612b75565b60116020525f908152604090205481565b6103a7610567366004612baf565b610ff5565b60015460ff16610316565b61058a610585366004612a3a565b6110a6565b604051610322929190612bd7565b61033e600b5481565b60045460ff1661036d565b6103a76105ba366004612c1b565b61115a565b6103a76105cd366004612cbc565b61137b565b6010546105df9060ff1681565b6040516103229190612d0c565b6103a76105fa366004612d32565b6113e5565b6103a761144e565b61060f61146d565b6040516103229796959493929190612d50565b6103a7610630366004612de4565b6114af565b610316610643366004612a9c565b61154a565b61033e60155481565b61033e60145481565b61033e610668366004612e16565b611572565b6103a761067b366004612a3a565b6115ee565b61031661068e366004612a3a565b60126020525f908152604090205460ff1681565b61033e5f81565b6103166106b7366004612a9c565b61163f565b6103a76106ca366004612e59565b61168e565b600e546104b690610100900461ffff1681565b61041a6116e9565b61036d601081565b6103a7610700366004612a9c565b611732565b6103a7610713366004612cbc565b611756565b61033e7f0ac90c257048ef1c3e387c26d4a99bde06894efbcbff862dc1885c3a9319308a81565b6103a761074d366004612e82565b6117e3565b60135461033e565b5f6001600160e01b03198216637965db0b60e01b148061078a57506301ffc9a760e01b6001600160e01b03198316145b92915050565b5f61079a336118a3565b6107a2611954565b5f6107b06201518085612ed5565b6107ba9085612efc565b90505f6107ca6201518085612ed5565b6107d49085612efc565b90505f5b6013548110156108805782601382815481106107f6576107f6612f0f565b905f5260205f200154101580156108285750816013828154811061081c5761081c612f0f565b905f5260205f20015411155b1561086e576013818154811061084057610840612f0f565b905f5260205f2001546040516306c5265160e21b815260040161086591815260200190565b60405180910390fd5b8061087881612f23565b9150506107d8565b506ec097ce7bc90715b34b9f1000000000825b82811161098b576108a8601062015180612f3b565b6108b29082612ed5565b1580156108e1575082620151806108ca601082612f3b565b6108d49084612f52565b6108de9190612efc565b11155b15610956575f600a816108f8601062015180612f3b565b6109029085612f65565b81526020019081526020015f20549050805f146109365761093383826ec097ce7bc90715b34b9f100000000061197a565b92505b610944601062015180612f3b565b61094e9083612f52565b915050610893565b5f818152600660205260408120549081131561097e5761097b83826305f5e10061197a565b92505b61094e6201518083612f52565b6109a86305f5e1006ec097ce7bc90715b34b9f1000000000612f65565b6109b29083612f65565b979650505050505050565b6060600580546109cc90612f78565b80601f01602080910402602001604051908101604052809291908181526020018280546109f890612f78565b8015610a435780601f10610a1a57610100808354040283529160200191610a43565b820191905f5260205f20905b815481529060010190602001808311610a2657829003601f168201915b5050505050905090565b5f80516020613086833981519152610a6481611a39565b5f610a726201518084612ed5565b610a7c9084612efc565b5f8181526006602052604081206001015491925003610ab15760405163bd13fe9f60e01b815260048101829052602401610865565b5f8181526012602052604090205460ff1615610acc57505050565b5f818152601260209081526040808320805460ff191660019081179091556013805491820181559093527f66de8ffda797e3de9c05e8fc57b3bf0ec28a930d40b0d285d93c06501cf6a090909201839055905133815282917f8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515910160405180910390a2505b5050565b604080516080810182525f808252602082018190529181018290526060810191909152610b81336118a3565b610b89611954565b5f610b976201518084612ed5565b610ba19084612efc565b9050610bac81611a43565b5f908152600660209081526040918290208251608081018452815481526001820154928101929092526002015460ff808216938301939093526101009004909116606082015290505b919050565b5f8080600f81610c0d6201518087612ed5565b610c179087612efc565b815260208101919091526040015f208054600290910154909560ff909116945092505050565b5f82815260208190526040902060010154610c5781611a39565b610c618383611a75565b50505050565b5f610c71336118a3565b610c79611954565b5f610c876201518085612ed5565b610c919085612efc565b90505f610ca16201518085612ed5565b610cab9085612efc565b90506305f5e100825b828111610d0c57610cc481611a43565b5f8181526006602052604081205412610cf8575f81815260066020526040902054610cf59083906305f5e10061197a565b91505b610d056201518082612f52565b9050610cb4565b5095945050505050565b6001600160a01b0381163314610d3f5760405163334bd91960e11b815260040160405180910390fd5b610d498282611b04565b505050565b5f610d5881611a39565b838214610d82576040516355c5b3e360e11b81526004810185905260248101839052604401610865565b5f5b84811015610eb957610dc95f80516020613066833981519152878784818110610daf57610daf612f0f565b9050602002016020810190610dc49190612b75565b611a75565b50838382818110610ddc57610ddc612f0f565b9050602002013560115f888885818110610df857610df8612f0f565b9050602002016020810190610e0d9190612b75565b6001600160a01b0316815260208101919091526040015f2055858582818110610e3857610e38612f0f565b9050602002016020810190610e4d9190612b75565b6001600160a01b03167f4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0858584818110610e8957610e89612f0f565b90506020020135604051610e9f91815260200190565b60405180910390a280610eb181612f23565b915050610d84565b505050505050565b5f80516020613086833981519152610ed881611a39565b610ee0611b3c565b50565b5f600160105460ff166002811115610efd57610efd612cf8565b03610f0a57506001919050565b610f215f805160206130668339815191528361154a565b610f2c57505f919050565b6001600160a01b0382165f908152601160205260408120549060105460ff166002811115610f5c57610f5c612cf8565b1480610f66575080155b80610f7057508042105b9392505050565b604080516080810182525f808252602082018190529181018290526060810191909152610fa3336118a3565b610fab611954565b600c5415610fbe57610fbe600b54611a43565b50604080516080810182526007548152600854602082015260095460ff808216938301939093526101009004909116606082015290565b5f610fff81611a39565b601060ff8416118061101657506127108261ffff16115b156110435760405163fee2146160e01b815260ff8416600482015261ffff83166024820152604401610865565b600e805460ff851662ffffff19909116811761010061ffff8616908102919091179092556040805191825260208201929092527ffbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556910160405180910390a1505050565b604080516080810182525f8082526020820181905291810182905260608101829052906110d233611b8e565b6110e7576110df336118a3565b6110e7611954565b5f6110f56201518085612ed5565b6110ff9085612efc565b5f90815260066020908152604080832060128352928190205481516080810183528454815260018501549381019390935260029093015460ff8181169284019290925261010090048116606083015290969116945092505050565b8242111561117e5760405163017f0c2160e61b815260048101849052602401610865565b5f61118c8888888888611572565b90505f806111cf8386868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250611be692505050565b5090925090505f8160038111156111e8576111e8612cf8565b1461120657604051638baa579f60e01b815260040160405180910390fd5b6112307f3204c940063673962b481a0395619b3dbbd137589c419e993978c1c71bcf68ec8361154a565b61125857604051633e3ad8f160e21b81526001600160a01b0383166004820152602401610865565b5f838152600d602090815260408083206001600160a01b038616845290915290205460ff16156112ad57604051634196a2bf60e01b8152600481018490526001600160a01b0383166024820152604401610865565b5f6112bb620151808c612ed5565b6112c5908c612efc565b5f8181526006602052604090206001015490915080158015906112e85750808a11155b15611310576040516323a5987760e11b81526004810183905260248101829052604401610865565b5f858152600d602090815260408083206001600160a01b03881684529091529020805460ff19166001179055600e5460ff161561135857611353848d8d8d611c2f565b61136d565b6113618b611e34565b61136d8c8c8c8c611f3c565b505050505050505050505050565b7f0ac90c257048ef1c3e387c26d4a99bde06894efbcbff862dc1885c3a9319308a6113a581611a39565b600e5460ff16156113c957604051630ffcbb7160e31b815260040160405180910390fd5b6113d284611e34565b6113de85858585611f3c565b5050505050565b5f6113ef81611a39565b6010805483919060ff1916600183600281111561140e5761140e612cf8565b02179055507f17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56826040516114429190612d0c565b60405180910390a15050565b5f8051602061308683398151915261146581611a39565b610ee0612086565b5f6060805f805f606061147e6120c1565b6114866120f3565b604080515f80825260208201909252600f60f81b9b939a50919850469750309650945092509050565b5f6114b981611a39565b828413156114e457604051630c06536560e31b81526004810185905260248101849052604401610865565b601484905560158390556016805461ffff191661ffff84169081179091556040805186815260208101869052908101919091527f42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf469060600160405180910390a150505050565b5f918252602082815260408084206001600160a01b0393909316845291905290205460ff1690565b604080517f5afc4ec52f9af4394c52f4d1fc6eaff0cea665d5cc56d61e00ea0a9ad47456ba6020820152908101869052606081018590526080810184905260ff831660a082015260c081018290525f906115e49060e00160405160208183030381529060405280519060200120612120565b9695505050505050565b5f8051602061308683398151915261160581611a39565b5f6116136201518084612ed5565b61161d9084612efc565b5f8181526012602052604090205490915060ff1615610d4957610d498161214c565b5f600f816116506201518086612ed5565b61165a9086612efc565b815260208082019290925260409081015f9081206001600160a01b038616825260030190925290205460ff16905092915050565b7f3204c940063673962b481a0395619b3dbbd137589c419e993978c1c71bcf68ec6116b881611a39565b600e5460ff165f036116dd57604051632b3c1cc960e11b815260040160405180910390fd5b610c6133858585611c2f565b6007546016545f9061ffff161580159061170457505f600c54115b801561170f57508115155b801561172c5750600b545f9081526012602052604090205460ff16155b90509091565b5f8281526020819052604090206001015461174c81611a39565b610c618383611b04565b5f61176081611a39565b5f61176e6201518087612ed5565b6117789087612efc565b5f818152600f60205260409020600201805460ff1916600117905590506117a186868686611f3c565b6040805186815233602082015282917f773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986910160405180910390a2505050505050565b5f6117ed81611a39565b5f5b82811015610c61576118345f8051602061306683398151915285858481811061181a5761181a612f0f565b905060200201602081019061182f9190612b75565b611b04565b5083838281811061184757611847612f0f565b905060200201602081019061185c9190612b75565b6001600160a01b03167f0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec60405160405180910390a28061189b81612f23565b9150506117ef565b600160105460ff1660028111156118bc576118bc612cf8565b036118c45750565b6118db5f805160206130668339815191528261223e565b6001600160a01b0381165f90815260116020526040902054600260105460ff16600281111561190c5761190c612cf8565b14801561191857508015155b80156119245750804210155b15610b515760405163204d73bb60e21b81526001600160a01b038316600482015260248101829052604401610865565b60015460ff16156119785760405163d93c066560e01b815260040160405180910390fd5b565b5f838302815f1985870982811083820303915050805f036119ae578382816119a4576119a4612ec1565b0492505050610f70565b8084116119ce5760405163227bc15360e01b815260040160405180910390fd5b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010186841190950394909402919094039290920491909117919091029150509392505050565b610ee0813361223e565b5f8181526012602052604090205460ff1615610ee0576040516306c5265160e21b815260048101829052602401610865565b5f611a80838361154a565b611afd575f838152602081815260408083206001600160a01b03861684529091529020805460ff19166001179055611ab53390565b6001600160a01b0316826001600160a01b0316847f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a450600161078a565b505f61078a565b5f5f805160206130668339815191528303611b32576001600160a01b0382165f908152601160205260408120555b610f708383612277565b611b446122e0565b6001805460ff191690557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa335b6040516001600160a01b03909116815260200160405180910390a1565b5f611b99818361154a565b80611bc95750611bc97f0ac90c257048ef1c3e387c26d4a99bde06894efbcbff862dc1885c3a9319308a8361154a565b8061078a575061078a5f805160206130868339815191528361154a565b5f805f8351604103611c1d576020840151604085015160608601515f1a611c0f88828585612303565b955095509550505050611c28565b505081515f91506002905b9250925092565b5f611c3d6201518085612ed5565b611c479085612efc565b5f818152600f6020526040902060028101549192509060ff1615611c80576040516241ec1d60e41b815260048101839052602401610865565b6001600160a01b0386165f90815260038201602052604090205460ff1615611ccd57604051636338a03760e11b8152600481018390526001600160a01b0387166024820152604401610865565b6001600160a01b0386165f90815260038201602090815260408220805460ff19166001908117909155835480820185558484529190922001859055810154831115611d1a57600181018390555b5f611d24826123cb565b90505f611d32828351612512565b90505f805b8351811015611dbf57611d63848281518110611d5557611d55612f0f565b6020026020010151846125df565b15611dad57838181518110611d7a57611d7a612f0f565b6020026020010151848380611d8e90612f23565b945081518110611da057611da0612f0f565b6020026020010181815250505b80611db781612f23565b915050611d37565b50600e5460ff16811015611dd7575050505050610c61565b5f611de28483612512565b9050611ded81611e34565b60028501805460ff1916600117905583515f90611e0b846064612f3b565b611e159190612f65565b9050611e278783886001015484611f3c565b5050505050505050505050565b601454151580611e45575060155415155b8015611e5d5750601454811280611e5d575060155481135b15611e915760145460155460405163e797616560e01b81526004810184905260248101929092526044820152606401610865565b5f80611e9b6116e9565b9150915080611ea957505050565b5f828413611ec057611ebb8484612fb0565b611eca565b611eca8385612fb0565b90505f80841215611ee357611ede84612fcf565b611ee5565b835b601654909150611ef99061ffff1682612f3b565b611f0561271084612f3b565b11156113de57601654604051630f6bd06560e11b8152600481018790526024810186905261ffff9091166044820152606401610865565b5f611f4a6201518086612ed5565b611f549086612efc565b604080516080810182528681526020810186905260045460ff908116928201929092529084166060820152600c54919250905f901580611f955750600b5483115b5f8481526006602052604081206001015491925003611fc357600c8054905f611fbd83612f23565b91905055505b5f83815260066020908152604091829020845181559084015160018201559083015160029091018054606085015160ff9081166101000261ffff19909216931692909217919091179055600b54831061205357600b83905581516007556020820151600855604082015160098054606085015160ff9081166101000261ffff199092169316929092179190911790555b61205e83878361264c565b5f8381526012602052604090205460ff161561207d5761207d8361214c565b50505050505050565b61208e611954565b6001805460ff1916811790557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a25833611b71565b60606120ee7f00000000000000000000000000000000000000000000000000000000000000006002612768565b905090565b60606120ee7f00000000000000000000000000000000000000000000000000000000000000006003612768565b5f61078a61212c612811565b8360405161190160f01b8152600281019290925260228201526042902090565b5f818152601260205260408120805460ff19169055601354905b8181101561220f57826013828154811061218257612182612f0f565b905f5260205f200154036121fd57601361219d600184612efc565b815481106121ad576121ad612f0f565b905f5260205f200154601382815481106121c9576121c9612f0f565b5f9182526020909120015560138054806121e5576121e5612fe9565b600190038181905f5260205f20015f9055905561220f565b8061220781612f23565b915050612166565b5060405182907f736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4905f90a25050565b612248828261154a565b610b515760405163e2517d3f60e01b81526001600160a01b038216600482015260248101839052604401610865565b5f612282838361154a565b15611afd575f838152602081815260408083206001600160a01b0386168085529252808320805460ff1916905551339286917ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b9190a450600161078a565b60015460ff1661197857604051638dfc202b60e01b815260040160405180910390fd5b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a084111561233c57505f915060039050826123c1565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa15801561238d573d5f803e3d5ffd5b5050604051601f1901519150506001600160a01b0381166123b857505f9250600191508290506123c1565b92505f91508190505b9450945094915050565b60605f8280548060200260200160405190810160405280929190818152602001828054801561241757602002820191905f5260205f20905b815481526020019060010190808311612403575b50939450600193505050505b815181101561250b575f82828151811061243f5761243f612f0f565b602002602001015190505f8290505b5f8111801561247f57508184612465600184612efc565b8151811061247557612475612f0f565b6020026020010151135b156124d75783612490600183612efc565b815181106124a0576124a0612f0f565b60200260200101518482815181106124ba576124ba612f0f565b6020908102919091010152806124cf81612ffd565b91505061244e565b818482815181106124ea576124ea612f0f565b6020026020010181815250505050808061250390612f23565b915050612423565b5092915050565b5f8061251f600284612f65565b905061252c600284612ed5565b6001036125555783818151811061254557612545612f0f565b602002602001015191505061078a565b600284612563600184612efc565b8151811061257357612573612f0f565b602002602001015185838151811061258d5761258d612f0f565b602002602001015161259f9190612fb0565b6125a99190613012565b846125b5600184612efc565b815181106125c5576125c5612f0f565b60200260200101516125d7919061303e565b949350505050565b5f808284136125f7576125f28484612fb0565b612601565b6126018385612fb0565b90505f8084121561261a5761261584612fcf565b61261c565b835b600e5490915061263590610100900461ffff1682612f3b565b61264161271084612f3b565b111595945050505050565b5f61265b601062015180612f3b565b6126659085612f65565b5f818152600a602052604090205490915082156126b457805f0361269557506ec097ce7bc90715b34b9f10000000005b5f8413156126af576126ac81856305f5e10061197a565b90505b612741565b506ec097ce7bc90715b34b9f10000000005f6126d4601062015180612f3b565b6126de9084612f3b565b9050805b6126f0601062015180612f3b565b6126fa9083612f52565b81101561273e575f81815260066020526040812054908113156127295761272684826305f5e10061197a565b93505b506127376201518082612f52565b90506126e2565b50505b801561274d5780612750565b60015b5f928352600a60205260409092209190915550505050565b606060ff83146127825761277b8361293a565b905061078a565b81805461278e90612f78565b80601f01602080910402602001604051908101604052809291908181526020018280546127ba90612f78565b80156128055780601f106127dc57610100808354040283529160200191612805565b820191905f5260205f20905b8154815290600101906020018083116127e857829003601f168201915b5050505050905061078a565b5f306001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614801561286957507f000000000000000000000000000000000000000000000000000000000000000046145b1561289357507f000000000000000000000000000000000000000000000000000000000000000090565b6120ee604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201527f0000000000000000000000000000000000000000000000000000000000000000918101919091527f000000000000000000000000000000000000000000000000000000000000000060608201524660808201523060a08201525f9060c00160405160208183030381529060405280519060200120905090565b60605f61294683612977565b6040805160208082528183019092529192505f91906020820181803683375050509182525060208101929092525090565b5f60ff8216601f81111561078a57604051632cd44ac360e21b815260040160405180910390fd5b5f602082840312156129ae575f80fd5b81356001600160e01b031981168114610f70575f80fd5b5f80604083850312156129d6575f80fd5b50508035926020909101359150565b5f81518084525f5b81811015612a09576020818501810151868301820152016129ed565b505f602082860101526020601f19601f83011685010191505092915050565b602081525f610f7060208301846129e5565b5f60208284031215612a4a575f80fd5b5035919050565b6080810161078a8284805182526020810151602083015260ff604082015116604083015260ff60608201511660608301525050565b80356001600160a01b0381168114610bf5575f80fd5b5f8060408385031215612aad575f80fd5b82359150612abd60208401612a86565b90509250929050565b5f8083601f840112612ad6575f80fd5b50813567ffffffffffffffff811115612aed575f80fd5b6020830191508360208260051b8501011115612b07575f80fd5b9250929050565b5f805f8060408587031215612b21575f80fd5b843567ffffffffffffffff80821115612b38575f80fd5b612b4488838901612ac6565b90965094506020870135915080821115612b5c575f80fd5b50612b6987828801612ac6565b95989497509550505050565b5f60208284031215612b85575f80fd5b610f7082612a86565b803560ff81168114610bf5575f80fd5b803561ffff81168114610bf5575f80fd5b5f8060408385031215612bc0575f80fd5b612bc983612b8e565b9150612abd60208401612b9e565b60a08101612c0c8285805182526020810151602083015260ff604082015116604083015260ff60608201511660608301525050565b82151560808301529392505050565b5f805f805f805f60c0888a031215612c31575f80fd5b873596506020880135955060408801359450612c4f60608901612b8e565b93506080880135925060a088013567ffffffffffffffff80821115612c72575f80fd5b818a0191508a601f830112612c85575f80fd5b813581811115612c93575f80fd5b8b6020828501011115612ca4575f80fd5b60208301945080935050505092959891949750929550565b5f805f8060808587031215612ccf575f80fd5b843593506020850135925060408501359150612ced60608601612b8e565b905092959194509250565b634e487b7160e01b5f52602160045260245ffd5b6020810160038310612d2c57634e487b7160e01b5f52602160045260245ffd5b91905290565b5f60208284031215612d42575f80fd5b813560038110610f70575f80fd5b60ff60f81b881681525f602060e081840152612d6f60e084018a6129e5565b8381036040850152612d81818a6129e5565b606085018990526001600160a01b038816608086015260a0850187905284810360c086015285518082528387019250908301905f5b81811015612dd257835183529284019291840191600101612db6565b50909c9b505050505050505050505050565b5f805f60608486031215612df6575f80fd5b8335925060208401359150612e0d60408501612b9e565b90509250925092565b5f805f805f60a08688031215612e2a575f80fd5b853594506020860135935060408601359250612e4860608701612b8e565b949793965091946080013592915050565b5f805f60608486031215612e6b575f80fd5b505081359360208301359350604090920135919050565b5f8060208385031215612e93575f80fd5b823567ffffffffffffffff811115612ea9575f80fd5b612eb585828601612ac6565b90969095509350505050565b634e487b7160e01b5f52601260045260245ffd5b5f82612ee357612ee3612ec1565b500690565b634e487b7160e01b5f52601160045260245ffd5b8181038181111561078a5761078a612ee8565b634e487b7160e01b5f52603260045260245ffd5b5f60018201612f3457612f34612ee8565b5060010190565b808202811582820484141761078a5761078a612ee8565b8082018082111561078a5761078a612ee8565b5f82612f7357612f73612ec1565b500490565b600181811c90821680612f8c57607f821691505b602082108103612faa57634e487b7160e01b5f52602260045260245ffd5b50919050565b8181035f83128015838313168383128216171561250b5761250b612ee8565b5f600160ff1b8201612fe357612fe3612ee8565b505f0390565b634e487b7160e01b5f52603160045260245ffd5b5f8161300b5761300b612ee8565b505f190190565b5f8261302057613020612ec1565b600160ff1b82145f198414161561303957613039612ee8565b500590565b8082018281125f83128015821682158216171561305d5761305d612ee8565b50509291505056feb46ce43d76047f77f110931243fb48b444c01f8ce7d297bf5cdc21cb7634e00055435dd261a4b9b3364963f7738a7a662ad9c84396d64be3365284bb7f0a5041a264697066735822122072086b20681e37019c7bb3c3fa2c81f0bdf9ab5318a8ba4989d7bf22fb1db38364736f6c63430008150033",
 }
 
-// ApiABI is the input ABI used to generate the binding from.
-// Deprecated: Use ApiMetaData.ABI instead.
-var ApiABI = ApiMetaData.ABI
+// OracleIndicatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use OracleIndicatorMetaData.ABI instead.
+var OracleIndicatorABI = OracleIndicatorMetaData.ABI
 
-// Api is an auto generated Go binding around an Ethereum contract.
-type Api struct {
-	ApiCaller     // Read-only binding to the contract
-	ApiTransactor // Write-only binding to the contract
-	ApiFilterer   // Log filterer for contract events
+// OracleIndicatorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use OracleIndicatorMetaData.Bin instead.
+var OracleIndicatorBin = OracleIndicatorMetaData.Bin
+
+// DeployOracleIndicator deploys a new Ethereum contract, binding an instance of OracleIndicator to it.
+func DeployOracleIndicator(auth *bind.TransactOpts, backend bind.ContractBackend, _name string, _decimals uint8, _defaultAdmin common.Address) (common.Address, *types.Transaction, *OracleIndicator, error) {
+	parsed, err := OracleIndicatorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(OracleIndicatorBin), backend, _name, _decimals, _defaultAdmin)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &OracleIndicator{OracleIndicatorCaller: OracleIndicatorCaller{contract: contract}, OracleIndicatorTransactor: OracleIndicatorTransactor{contract: contract}, OracleIndicatorFilterer: OracleIndicatorFilterer{contract: contract}}, nil
+}
+
+// OracleIndicator is an auto generated Go binding around an Ethereum contract.
+type OracleIndicator struct {
+	OracleIndicatorCaller     // Read-only binding to the contract
+	OracleIndicatorTransactor // Write-only binding to the contract
+	OracleIndicatorFilterer   // Log filterer for contract events
 }
 
-// ApiCaller is an auto generated read-only Go binding around an Ethereum contract.
-type ApiCaller struct {
+// OracleIndicatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OracleIndicatorCaller struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// ApiTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type ApiTransactor struct {
+// OracleIndicatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OracleIndicatorTransactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// ApiFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type ApiFilterer struct {
+// OracleIndicatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OracleIndicatorFilterer struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
-// ApiSession is an auto generated Go binding around an Ethereum contract,
+// OracleIndicatorSession is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
-type ApiSession struct {
-	Contract     *Api              // Generic contract binding to set the session for
+type OracleIndicatorSession struct {
+	Contract     *OracleIndicator  // Generic contract binding to set the session for
 	CallOpts     bind.CallOpts     // Call options to use throughout this session
 	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
 }
 
-// ApiCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// OracleIndicatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
 // with pre-set call options.
-type ApiCallerSession struct {
-	Contract *ApiCaller    // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts // Call options to use throughout this session
+type OracleIndicatorCallerSession struct {
+	Contract *OracleIndicatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
 }
 
-// ApiTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// OracleIndicatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
 // with pre-set transact options.
-type ApiTransactorSession struct {
-	Contract     *ApiTransactor    // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+type OracleIndicatorTransactorSession struct {
+	Contract     *OracleIndicatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
 }
 
-// ApiRaw is an auto generated low-level Go binding around an Ethereum contract.
-type ApiRaw struct {
-	Contract *Api // Generic contract binding to access the raw methods on
+// OracleIndicatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OracleIndicatorRaw struct {
+	Contract *OracleIndicator // Generic contract binding to access the raw methods on
 }
 
-// ApiCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type ApiCallerRaw struct {
-	Contract *ApiCaller // Generic read-only contract binding to access the raw methods on
+// OracleIndicatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OracleIndicatorCallerRaw struct {
+	Contract *OracleIndicatorCaller // Generic read-only contract binding to access the raw methods on
 }
 
-// ApiTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type ApiTransactorRaw struct {
-	Contract *ApiTransactor // Generic write-only contract binding to access the raw methods on
+// OracleIndicatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OracleIndicatorTransactorRaw struct {
+	Contract *OracleIndicatorTransactor // Generic write-only contract binding to access the raw methods on
 }
 
-// NewApi creates a new instance of Api, bound to a specific deployed contract.
-func NewApi(address common.Address, backend bind.ContractBackend) (*Api, error) {
-	contract, err := bindApi(address, backend, backend, backend)
+// NewOracleIndicator creates a new instance of OracleIndicator, bound to a specific deployed contract.
+func NewOracleIndicator(address common.Address, backend bind.ContractBackend) (*OracleIndicator, error) {
+	contract, err := bindOracleIndicator(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &Api{ApiCaller: ApiCaller{contract: contract}, ApiTransactor: ApiTransactor{contract: contract}, ApiFilterer: ApiFilterer{contract: contract}}, nil
+	return &OracleIndicator{OracleIndicatorCaller: OracleIndicatorCaller{contract: contract}, OracleIndicatorTransactor: OracleIndicatorTransactor{contract: contract}, OracleIndicatorFilterer: OracleIndicatorFilterer{contract: contract}}, nil
 }
 
-// NewApiCaller creates a new read-only instance of Api, bound to a specific deployed contract.
-func NewApiCaller(address common.Address, caller bind.ContractCaller) (*ApiCaller, error) {
-	contract, err := bindApi(address, caller, nil, nil)
+// NewOracleIndicatorCaller creates a new read-only instance of OracleIndicator, bound to a specific deployed contract.
+func NewOracleIndicatorCaller(address common.Address, caller bind.ContractCaller) (*OracleIndicatorCaller, error) {
+	contract, err := bindOracleIndicator(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ApiCaller{contract: contract}, nil
+	return &OracleIndicatorCaller{contract: contract}, nil
 }
 
-// NewApiTransactor creates a new write-only instance of Api, bound to a specific deployed contract.
-func NewApiTransactor(address common.Address, transactor bind.ContractTransactor) (*ApiTransactor, error) {
-	contract, err := bindApi(address, nil, transactor, nil)
+// NewOracleIndicatorTransactor creates a new write-only instance of OracleIndicator, bound to a specific deployed contract.
+func NewOracleIndicatorTransactor(address common.Address, transactor bind.ContractTransactor) (*OracleIndicatorTransactor, error) {
+	contract, err := bindOracleIndicator(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ApiTransactor{contract: contract}, nil
+	return &OracleIndicatorTransactor{contract: contract}, nil
 }
 
-// NewApiFilterer creates a new log filterer instance of Api, bound to a specific deployed contract.
-func NewApiFilterer(address common.Address, filterer bind.ContractFilterer) (*ApiFilterer, error) {
-	contract, err := bindApi(address, nil, nil, filterer)
+// NewOracleIndicatorFilterer creates a new log filterer instance of OracleIndicator, bound to a specific deployed contract.
+func NewOracleIndicatorFilterer(address common.Address, filterer bind.ContractFilterer) (*OracleIndicatorFilterer, error) {
+	contract, err := bindOracleIndicator(address, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
-	return &ApiFilterer{contract: contract}, nil
+	return &OracleIndicatorFilterer{contract: contract}, nil
 }
 
-// bindApi binds a generic wrapper to an already deployed contract.
-func bindApi(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := ApiMetaData.GetAbi()
+// bindOracleIndicator binds a generic wrapper to an already deployed contract.
+func bindOracleIndicator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OracleIndicatorMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
@@ -154,46 +176,46 @@ func bindApi(address common.Address, caller bind.ContractCaller, transactor bind
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_Api *ApiRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _Api.Contract.ApiCaller.contract.Call(opts, result, method, params...)
+func (_OracleIndicator *OracleIndicatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicator.Contract.OracleIndicatorCaller.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_Api *ApiRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Api.Contract.ApiTransactor.contract.Transfer(opts)
+func (_OracleIndicator *OracleIndicatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.OracleIndicatorTransactor.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_Api *ApiRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _Api.Contract.ApiTransactor.contract.Transact(opts, method, params...)
+func (_OracleIndicator *OracleIndicatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.OracleIndicatorTransactor.contract.Transact(opts, method, params...)
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_Api *ApiCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _Api.Contract.contract.Call(opts, result, method, params...)
+func (_OracleIndicator *OracleIndicatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicator.Contract.contract.Call(opts, result, method, params...)
 }
 
 // Transfer initiates a plain transaction to move funds to the contract, calling
 // its default method if one is available.
-func (_Api *ApiTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _Api.Contract.contract.Transfer(opts)
+func (_OracleIndicator *OracleIndicatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.contract.Transfer(opts)
 }
 
 // Transact invokes the (paid) contract method with params as input values.
-func (_Api *ApiTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _Api.Contract.contract.Transact(opts, method, params...)
+func (_OracleIndicator *OracleIndicatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.contract.Transact(opts, method, params...)
 }
 
 // DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
 //
 // Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
-func (_Api *ApiCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+func (_OracleIndicator *OracleIndicatorCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+	err := _OracleIndicator.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
 
 	if err != nil {
 		return *new([32]byte), err
@@ -208,23 +230,116 @@ func (_Api *ApiCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
 // DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
 //
 // Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
-func (_Api *ApiSession) DEFAULTADMINROLE() ([32]byte, error) {
-	return _Api.Contract.DEFAULTADMINROLE(&_Api.CallOpts)
+func (_OracleIndicator *OracleIndicatorSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.DEFAULTADMINROLE(&_OracleIndicator.CallOpts)
 }
 
 // DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
 //
 // Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
-func (_Api *ApiCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
-	return _Api.Contract.DEFAULTADMINROLE(&_Api.CallOpts)
+func (_OracleIndicator *OracleIndicatorCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.DEFAULTADMINROLE(&_OracleIndicator.CallOpts)
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCaller) GUARDIANROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "GUARDIAN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorSession) GUARDIANROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.GUARDIANROLE(&_OracleIndicator.CallOpts)
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCallerSession) GUARDIANROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.GUARDIANROLE(&_OracleIndicator.CallOpts)
+}
+
+// MAXQUORUM is a free data retrieval call binding the contract method 0xd2cbc867.
+//
+// Solidity: function MAX_QUORUM() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCaller) MAXQUORUM(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "MAX_QUORUM")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// MAXQUORUM is a free data retrieval call binding the contract method 0xd2cbc867.
+//
+// Solidity: function MAX_QUORUM() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorSession) MAXQUORUM() (uint8, error) {
+	return _OracleIndicator.Contract.MAXQUORUM(&_OracleIndicator.CallOpts)
+}
+
+// MAXQUORUM is a free data retrieval call binding the contract method 0xd2cbc867.
+//
+// Solidity: function MAX_QUORUM() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCallerSession) MAXQUORUM() (uint8, error) {
+	return _OracleIndicator.Contract.MAXQUORUM(&_OracleIndicator.CallOpts)
+}
+
+// PUBLISHERROLE is a free data retrieval call binding the contract method 0xd8f4b6fd.
+//
+// Solidity: function PUBLISHER_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCaller) PUBLISHERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "PUBLISHER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PUBLISHERROLE is a free data retrieval call binding the contract method 0xd8f4b6fd.
+//
+// Solidity: function PUBLISHER_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorSession) PUBLISHERROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.PUBLISHERROLE(&_OracleIndicator.CallOpts)
+}
+
+// PUBLISHERROLE is a free data retrieval call binding the contract method 0xd8f4b6fd.
+//
+// Solidity: function PUBLISHER_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCallerSession) PUBLISHERROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.PUBLISHERROLE(&_OracleIndicator.CallOpts)
 }
 
 // READONLY is a free data retrieval call binding the contract method 0x15eecf21.
 //
 // Solidity: function READ_ONLY() view returns(bytes32)
-func (_Api *ApiCaller) READONLY(opts *bind.CallOpts) ([32]byte, error) {
+func (_OracleIndicator *OracleIndicatorCaller) READONLY(opts *bind.CallOpts) ([32]byte, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "READ_ONLY")
+	err := _OracleIndicator.contract.Call(opts, &out, "READ_ONLY")
 
 	if err != nil {
 		return *new([32]byte), err
@@ -239,23 +354,85 @@ func (_Api *ApiCaller) READONLY(opts *bind.CallOpts) ([32]byte, error) {
 // READONLY is a free data retrieval call binding the contract method 0x15eecf21.
 //
 // Solidity: function READ_ONLY() view returns(bytes32)
-func (_Api *ApiSession) READONLY() ([32]byte, error) {
-	return _Api.Contract.READONLY(&_Api.CallOpts)
+func (_OracleIndicator *OracleIndicatorSession) READONLY() ([32]byte, error) {
+	return _OracleIndicator.Contract.READONLY(&_OracleIndicator.CallOpts)
 }
 
 // READONLY is a free data retrieval call binding the contract method 0x15eecf21.
 //
 // Solidity: function READ_ONLY() view returns(bytes32)
-func (_Api *ApiCallerSession) READONLY() ([32]byte, error) {
-	return _Api.Contract.READONLY(&_Api.CallOpts)
+func (_OracleIndicator *OracleIndicatorCallerSession) READONLY() ([32]byte, error) {
+	return _OracleIndicator.Contract.READONLY(&_OracleIndicator.CallOpts)
 }
 
-// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+// REPORTERROLE is a free data retrieval call binding the contract method 0x3f60d799.
 //
-// Solidity: function decimal() view returns(uint8)
-func (_Api *ApiCaller) Decimal(opts *bind.CallOpts) (uint8, error) {
+// Solidity: function REPORTER_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCaller) REPORTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "REPORTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REPORTERROLE is a free data retrieval call binding the contract method 0x3f60d799.
+//
+// Solidity: function REPORTER_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorSession) REPORTERROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.REPORTERROLE(&_OracleIndicator.CallOpts)
+}
+
+// REPORTERROLE is a free data retrieval call binding the contract method 0x3f60d799.
+//
+// Solidity: function REPORTER_ROLE() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCallerSession) REPORTERROLE() ([32]byte, error) {
+	return _OracleIndicator.Contract.REPORTERROLE(&_OracleIndicator.CallOpts)
+}
+
+// REPORTTYPEHASH is a free data retrieval call binding the contract method 0x4a882fc3.
+//
+// Solidity: function REPORT_TYPEHASH() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCaller) REPORTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "REPORT_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REPORTTYPEHASH is a free data retrieval call binding the contract method 0x4a882fc3.
+//
+// Solidity: function REPORT_TYPEHASH() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorSession) REPORTTYPEHASH() ([32]byte, error) {
+	return _OracleIndicator.Contract.REPORTTYPEHASH(&_OracleIndicator.CallOpts)
+}
+
+// REPORTTYPEHASH is a free data retrieval call binding the contract method 0x4a882fc3.
+//
+// Solidity: function REPORT_TYPEHASH() view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCallerSession) REPORTTYPEHASH() ([32]byte, error) {
+	return _OracleIndicator.Contract.REPORTTYPEHASH(&_OracleIndicator.CallOpts)
+}
+
+// AccessMode is a free data retrieval call binding the contract method 0x7b5c6e28.
+//
+// Solidity: function accessMode() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCaller) AccessMode(opts *bind.CallOpts) (uint8, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "decimal")
+	err := _OracleIndicator.contract.Call(opts, &out, "accessMode")
 
 	if err != nil {
 		return *new(uint8), err
@@ -267,57 +444,57 @@ func (_Api *ApiCaller) Decimal(opts *bind.CallOpts) (uint8, error) {
 
 }
 
-// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+// AccessMode is a free data retrieval call binding the contract method 0x7b5c6e28.
 //
-// Solidity: function decimal() view returns(uint8)
-func (_Api *ApiSession) Decimal() (uint8, error) {
-	return _Api.Contract.Decimal(&_Api.CallOpts)
+// Solidity: function accessMode() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorSession) AccessMode() (uint8, error) {
+	return _OracleIndicator.Contract.AccessMode(&_OracleIndicator.CallOpts)
 }
 
-// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+// AccessMode is a free data retrieval call binding the contract method 0x7b5c6e28.
 //
-// Solidity: function decimal() view returns(uint8)
-func (_Api *ApiCallerSession) Decimal() (uint8, error) {
-	return _Api.Contract.Decimal(&_Api.CallOpts)
+// Solidity: function accessMode() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCallerSession) AccessMode() (uint8, error) {
+	return _OracleIndicator.Contract.AccessMode(&_OracleIndicator.CallOpts)
 }
 
-// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+// CanRead is a free data retrieval call binding the contract method 0x42087d4f.
 //
-// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
-func (_Api *ApiCaller) GetDate(opts *bind.CallOpts, _timestamp *big.Int) (OracleIndicatorDataFeed, error) {
+// Solidity: function canRead(address _account) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) CanRead(opts *bind.CallOpts, _account common.Address) (bool, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "getDate", _timestamp)
+	err := _OracleIndicator.contract.Call(opts, &out, "canRead", _account)
 
 	if err != nil {
-		return *new(OracleIndicatorDataFeed), err
+		return *new(bool), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(OracleIndicatorDataFeed)).(*OracleIndicatorDataFeed)
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
 
 	return out0, err
 
 }
 
-// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+// CanRead is a free data retrieval call binding the contract method 0x42087d4f.
 //
-// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
-func (_Api *ApiSession) GetDate(_timestamp *big.Int) (OracleIndicatorDataFeed, error) {
-	return _Api.Contract.GetDate(&_Api.CallOpts, _timestamp)
+// Solidity: function canRead(address _account) view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) CanRead(_account common.Address) (bool, error) {
+	return _OracleIndicator.Contract.CanRead(&_OracleIndicator.CallOpts, _account)
 }
 
-// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+// CanRead is a free data retrieval call binding the contract method 0x42087d4f.
 //
-// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
-func (_Api *ApiCallerSession) GetDate(_timestamp *big.Int) (OracleIndicatorDataFeed, error) {
-	return _Api.Contract.GetDate(&_Api.CallOpts, _timestamp)
+// Solidity: function canRead(address _account) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) CanRead(_account common.Address) (bool, error) {
+	return _OracleIndicator.Contract.CanRead(&_OracleIndicator.CallOpts, _account)
 }
 
-// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
 //
-// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
-func (_Api *ApiCaller) GetInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCaller) CheckpointCount(opts *bind.CallOpts) (*big.Int, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "getInterval", _start, _end)
+	err := _OracleIndicator.contract.Call(opts, &out, "checkpointCount")
 
 	if err != nil {
 		return *new(*big.Int), err
@@ -329,317 +506,2642 @@ func (_Api *ApiCaller) GetInterval(opts *bind.CallOpts, _start *big.Int, _end *b
 
 }
 
-// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
 //
-// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
-func (_Api *ApiSession) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
-	return _Api.Contract.GetInterval(&_Api.CallOpts, _start, _end)
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorSession) CheckpointCount() (*big.Int, error) {
+	return _OracleIndicator.Contract.CheckpointCount(&_OracleIndicator.CallOpts)
 }
 
-// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
 //
-// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
-func (_Api *ApiCallerSession) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
-	return _Api.Contract.GetInterval(&_Api.CallOpts, _start, _end)
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCallerSession) CheckpointCount() (*big.Int, error) {
+	return _OracleIndicator.Contract.CheckpointCount(&_OracleIndicator.CallOpts)
 }
 
-// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+// ConsensusRound is a free data retrieval call binding the contract method 0x2c0af1ce.
 //
-// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
-func (_Api *ApiCaller) GetLast(opts *bind.CallOpts) (OracleIndicatorDataFeed, error) {
+// Solidity: function consensusRound(uint256 _timestamp) view returns(uint256 submissions, bool finalized)
+func (_OracleIndicator *OracleIndicatorCaller) ConsensusRound(opts *bind.CallOpts, _timestamp *big.Int) (struct {
+	Submissions *big.Int
+	Finalized   bool
+}, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "getLast")
+	err := _OracleIndicator.contract.Call(opts, &out, "consensusRound", _timestamp)
 
+	outstruct := new(struct {
+		Submissions *big.Int
+		Finalized   bool
+	})
 	if err != nil {
-		return *new(OracleIndicatorDataFeed), err
+		return *outstruct, err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(OracleIndicatorDataFeed)).(*OracleIndicatorDataFeed)
+	outstruct.Submissions = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Finalized = *abi.ConvertType(out[1], new(bool)).(*bool)
 
-	return out0, err
+	return *outstruct, err
 
 }
 
-// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+// ConsensusRound is a free data retrieval call binding the contract method 0x2c0af1ce.
 //
-// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
-func (_Api *ApiSession) GetLast() (OracleIndicatorDataFeed, error) {
-	return _Api.Contract.GetLast(&_Api.CallOpts)
+// Solidity: function consensusRound(uint256 _timestamp) view returns(uint256 submissions, bool finalized)
+func (_OracleIndicator *OracleIndicatorSession) ConsensusRound(_timestamp *big.Int) (struct {
+	Submissions *big.Int
+	Finalized   bool
+}, error) {
+	return _OracleIndicator.Contract.ConsensusRound(&_OracleIndicator.CallOpts, _timestamp)
 }
 
-// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+// ConsensusRound is a free data retrieval call binding the contract method 0x2c0af1ce.
 //
-// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
-func (_Api *ApiCallerSession) GetLast() (OracleIndicatorDataFeed, error) {
-	return _Api.Contract.GetLast(&_Api.CallOpts)
+// Solidity: function consensusRound(uint256 _timestamp) view returns(uint256 submissions, bool finalized)
+func (_OracleIndicator *OracleIndicatorCallerSession) ConsensusRound(_timestamp *big.Int) (struct {
+	Submissions *big.Int
+	Finalized   bool
+}, error) {
+	return _OracleIndicator.Contract.ConsensusRound(&_OracleIndicator.CallOpts, _timestamp)
 }
 
-// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
 //
-// Solidity: function getName() view returns(string)
-func (_Api *ApiCaller) GetName(opts *bind.CallOpts) (string, error) {
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCaller) Decimal(opts *bind.CallOpts) (uint8, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "getName")
+	err := _OracleIndicator.contract.Call(opts, &out, "decimal")
 
 	if err != nil {
-		return *new(string), err
+		return *new(uint8), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
 
 	return out0, err
 
 }
 
-// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
 //
-// Solidity: function getName() view returns(string)
-func (_Api *ApiSession) GetName() (string, error) {
-	return _Api.Contract.GetName(&_Api.CallOpts)
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorSession) Decimal() (uint8, error) {
+	return _OracleIndicator.Contract.Decimal(&_OracleIndicator.CallOpts)
 }
 
-// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
 //
-// Solidity: function getName() view returns(string)
-func (_Api *ApiCallerSession) GetName() (string, error) {
-	return _Api.Contract.GetName(&_Api.CallOpts)
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCallerSession) Decimal() (uint8, error) {
+	return _OracleIndicator.Contract.Decimal(&_OracleIndicator.CallOpts)
 }
 
-// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+// DeviationBase is a free data retrieval call binding the contract method 0xd1607cdb.
 //
-// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
-func (_Api *ApiCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+// Solidity: function deviationBase() view returns(int256 value, bool active)
+func (_OracleIndicator *OracleIndicatorCaller) DeviationBase(opts *bind.CallOpts) (struct {
+	Value  *big.Int
+	Active bool
+}, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "getRoleAdmin", role)
+	err := _OracleIndicator.contract.Call(opts, &out, "deviationBase")
 
+	outstruct := new(struct {
+		Value  *big.Int
+		Active bool
+	})
 	if err != nil {
-		return *new([32]byte), err
+		return *outstruct, err
 	}
 
-	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Active = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// DeviationBase is a free data retrieval call binding the contract method 0xd1607cdb.
+//
+// Solidity: function deviationBase() view returns(int256 value, bool active)
+func (_OracleIndicator *OracleIndicatorSession) DeviationBase() (struct {
+	Value  *big.Int
+	Active bool
+}, error) {
+	return _OracleIndicator.Contract.DeviationBase(&_OracleIndicator.CallOpts)
+}
+
+// DeviationBase is a free data retrieval call binding the contract method 0xd1607cdb.
+//
+// Solidity: function deviationBase() view returns(int256 value, bool active)
+func (_OracleIndicator *OracleIndicatorCallerSession) DeviationBase() (struct {
+	Value  *big.Int
+	Active bool
+}, error) {
+	return _OracleIndicator.Contract.DeviationBase(&_OracleIndicator.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_OracleIndicator *OracleIndicatorCaller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_OracleIndicator *OracleIndicatorSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _OracleIndicator.Contract.Eip712Domain(&_OracleIndicator.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_OracleIndicator *OracleIndicatorCallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _OracleIndicator.Contract.Eip712Domain(&_OracleIndicator.CallOpts)
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicator *OracleIndicatorCaller) GetCumulativeInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "getCumulativeInterval", _start, _end)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
 
 	return out0, err
 
 }
 
-// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
 //
-// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
-func (_Api *ApiSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
-	return _Api.Contract.GetRoleAdmin(&_Api.CallOpts, role)
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicator *OracleIndicatorSession) GetCumulativeInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicator.Contract.GetCumulativeInterval(&_OracleIndicator.CallOpts, _start, _end)
 }
 
-// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
 //
-// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
-func (_Api *ApiCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
-	return _Api.Contract.GetRoleAdmin(&_Api.CallOpts, role)
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicator *OracleIndicatorCallerSession) GetCumulativeInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicator.Contract.GetCumulativeInterval(&_OracleIndicator.CallOpts, _start, _end)
 }
 
-// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
 //
-// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
-func (_Api *ApiCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicator *OracleIndicatorCaller) GetDate(opts *bind.CallOpts, _timestamp *big.Int) (OracleIndicatorDataFeed, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "hasRole", role, account)
+	err := _OracleIndicator.contract.Call(opts, &out, "getDate", _timestamp)
 
 	if err != nil {
-		return *new(bool), err
+		return *new(OracleIndicatorDataFeed), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	out0 := *abi.ConvertType(out[0], new(OracleIndicatorDataFeed)).(*OracleIndicatorDataFeed)
 
 	return out0, err
 
 }
 
-// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
 //
-// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
-func (_Api *ApiSession) HasRole(role [32]byte, account common.Address) (bool, error) {
-	return _Api.Contract.HasRole(&_Api.CallOpts, role, account)
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicator *OracleIndicatorSession) GetDate(_timestamp *big.Int) (OracleIndicatorDataFeed, error) {
+	return _OracleIndicator.Contract.GetDate(&_OracleIndicator.CallOpts, _timestamp)
 }
 
-// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
 //
-// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
-func (_Api *ApiCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
-	return _Api.Contract.HasRole(&_Api.CallOpts, role, account)
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicator *OracleIndicatorCallerSession) GetDate(_timestamp *big.Int) (OracleIndicatorDataFeed, error) {
+	return _OracleIndicator.Contract.GetDate(&_OracleIndicator.CallOpts, _timestamp)
 }
 
-// Indicators is a free data retrieval call binding the contract method 0x92c871d2.
+// GetDay is a free data retrieval call binding the contract method 0x65c72840.
 //
-// Solidity: function indicators(uint256 ) view returns(int256 value, uint256 updatedat, uint8 decimal, uint8 confidence)
-func (_Api *ApiCaller) Indicators(opts *bind.CallOpts, arg0 *big.Int) (struct {
-	Value      *big.Int
-	Updatedat  *big.Int
-	Decimal    uint8
-	Confidence uint8
+// Solidity: function getDay(uint256 _timestamp) view returns((int256,uint256,uint8,uint8) feed, bool isRetracted)
+func (_OracleIndicator *OracleIndicatorCaller) GetDay(opts *bind.CallOpts, _timestamp *big.Int) (struct {
+	Feed        OracleIndicatorDataFeed
+	IsRetracted bool
 }, error) {
 	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "indicators", arg0)
+	err := _OracleIndicator.contract.Call(opts, &out, "getDay", _timestamp)
 
 	outstruct := new(struct {
-		Value      *big.Int
-		Updatedat  *big.Int
-		Decimal    uint8
-		Confidence uint8
+		Feed        OracleIndicatorDataFeed
+		IsRetracted bool
 	})
 	if err != nil {
 		return *outstruct, err
 	}
 
-	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
-	outstruct.Updatedat = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
-	outstruct.Decimal = *abi.ConvertType(out[2], new(uint8)).(*uint8)
-	outstruct.Confidence = *abi.ConvertType(out[3], new(uint8)).(*uint8)
+	outstruct.Feed = *abi.ConvertType(out[0], new(OracleIndicatorDataFeed)).(*OracleIndicatorDataFeed)
+	outstruct.IsRetracted = *abi.ConvertType(out[1], new(bool)).(*bool)
 
 	return *outstruct, err
 
 }
 
-// Indicators is a free data retrieval call binding the contract method 0x92c871d2.
+// GetDay is a free data retrieval call binding the contract method 0x65c72840.
 //
-// Solidity: function indicators(uint256 ) view returns(int256 value, uint256 updatedat, uint8 decimal, uint8 confidence)
-func (_Api *ApiSession) Indicators(arg0 *big.Int) (struct {
-	Value      *big.Int
-	Updatedat  *big.Int
-	Decimal    uint8
-	Confidence uint8
+// Solidity: function getDay(uint256 _timestamp) view returns((int256,uint256,uint8,uint8) feed, bool isRetracted)
+func (_OracleIndicator *OracleIndicatorSession) GetDay(_timestamp *big.Int) (struct {
+	Feed        OracleIndicatorDataFeed
+	IsRetracted bool
 }, error) {
-	return _Api.Contract.Indicators(&_Api.CallOpts, arg0)
+	return _OracleIndicator.Contract.GetDay(&_OracleIndicator.CallOpts, _timestamp)
 }
 
-// Indicators is a free data retrieval call binding the contract method 0x92c871d2.
+// GetDay is a free data retrieval call binding the contract method 0x65c72840.
 //
-// Solidity: function indicators(uint256 ) view returns(int256 value, uint256 updatedat, uint8 decimal, uint8 confidence)
-func (_Api *ApiCallerSession) Indicators(arg0 *big.Int) (struct {
-	Value      *big.Int
-	Updatedat  *big.Int
-	Decimal    uint8
-	Confidence uint8
+// Solidity: function getDay(uint256 _timestamp) view returns((int256,uint256,uint8,uint8) feed, bool isRetracted)
+func (_OracleIndicator *OracleIndicatorCallerSession) GetDay(_timestamp *big.Int) (struct {
+	Feed        OracleIndicatorDataFeed
+	IsRetracted bool
 }, error) {
-	return _Api.Contract.Indicators(&_Api.CallOpts, arg0)
+	return _OracleIndicator.Contract.GetDay(&_OracleIndicator.CallOpts, _timestamp)
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicator *OracleIndicatorCaller) GetInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "getInterval", _start, _end)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicator *OracleIndicatorSession) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicator.Contract.GetInterval(&_OracleIndicator.CallOpts, _start, _end)
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicator *OracleIndicatorCallerSession) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicator.Contract.GetInterval(&_OracleIndicator.CallOpts, _start, _end)
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicator *OracleIndicatorCaller) GetLast(opts *bind.CallOpts) (OracleIndicatorDataFeed, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "getLast")
+
+	if err != nil {
+		return *new(OracleIndicatorDataFeed), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(OracleIndicatorDataFeed)).(*OracleIndicatorDataFeed)
+
+	return out0, err
+
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicator *OracleIndicatorSession) GetLast() (OracleIndicatorDataFeed, error) {
+	return _OracleIndicator.Contract.GetLast(&_OracleIndicator.CallOpts)
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicator *OracleIndicatorCallerSession) GetLast() (OracleIndicatorDataFeed, error) {
+	return _OracleIndicator.Contract.GetLast(&_OracleIndicator.CallOpts)
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicator *OracleIndicatorCaller) GetName(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "getName")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicator *OracleIndicatorSession) GetName() (string, error) {
+	return _OracleIndicator.Contract.GetName(&_OracleIndicator.CallOpts)
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicator *OracleIndicatorCallerSession) GetName() (string, error) {
+	return _OracleIndicator.Contract.GetName(&_OracleIndicator.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _OracleIndicator.Contract.GetRoleAdmin(&_OracleIndicator.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _OracleIndicator.Contract.GetRoleAdmin(&_OracleIndicator.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _OracleIndicator.Contract.HasRole(&_OracleIndicator.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _OracleIndicator.Contract.HasRole(&_OracleIndicator.CallOpts, role, account)
+}
+
+// HasSubmitted is a free data retrieval call binding the contract method 0xa57d3806.
+//
+// Solidity: function hasSubmitted(uint256 _timestamp, address _reporter) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) HasSubmitted(opts *bind.CallOpts, _timestamp *big.Int, _reporter common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "hasSubmitted", _timestamp, _reporter)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasSubmitted is a free data retrieval call binding the contract method 0xa57d3806.
+//
+// Solidity: function hasSubmitted(uint256 _timestamp, address _reporter) view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) HasSubmitted(_timestamp *big.Int, _reporter common.Address) (bool, error) {
+	return _OracleIndicator.Contract.HasSubmitted(&_OracleIndicator.CallOpts, _timestamp, _reporter)
+}
+
+// HasSubmitted is a free data retrieval call binding the contract method 0xa57d3806.
+//
+// Solidity: function hasSubmitted(uint256 _timestamp, address _reporter) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) HasSubmitted(_timestamp *big.Int, _reporter common.Address) (bool, error) {
+	return _OracleIndicator.Contract.HasSubmitted(&_OracleIndicator.CallOpts, _timestamp, _reporter)
+}
+
+// LastDay is a free data retrieval call binding the contract method 0x6b0c932d.
+//
+// Solidity: function lastDay() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCaller) LastDay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "lastDay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastDay is a free data retrieval call binding the contract method 0x6b0c932d.
+//
+// Solidity: function lastDay() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorSession) LastDay() (*big.Int, error) {
+	return _OracleIndicator.Contract.LastDay(&_OracleIndicator.CallOpts)
+}
+
+// LastDay is a free data retrieval call binding the contract method 0x6b0c932d.
+//
+// Solidity: function lastDay() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCallerSession) LastDay() (*big.Int, error) {
+	return _OracleIndicator.Contract.LastDay(&_OracleIndicator.CallOpts)
+}
+
+// MaxDeviationBps is a free data retrieval call binding the contract method 0x3ee7a701.
+//
+// Solidity: function maxDeviationBps() view returns(uint16)
+func (_OracleIndicator *OracleIndicatorCaller) MaxDeviationBps(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "maxDeviationBps")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// MaxDeviationBps is a free data retrieval call binding the contract method 0x3ee7a701.
+//
+// Solidity: function maxDeviationBps() view returns(uint16)
+func (_OracleIndicator *OracleIndicatorSession) MaxDeviationBps() (uint16, error) {
+	return _OracleIndicator.Contract.MaxDeviationBps(&_OracleIndicator.CallOpts)
+}
+
+// MaxDeviationBps is a free data retrieval call binding the contract method 0x3ee7a701.
+//
+// Solidity: function maxDeviationBps() view returns(uint16)
+func (_OracleIndicator *OracleIndicatorCallerSession) MaxDeviationBps() (uint16, error) {
+	return _OracleIndicator.Contract.MaxDeviationBps(&_OracleIndicator.CallOpts)
+}
+
+// MaxValue is a free data retrieval call binding the contract method 0x94a5c2e4.
+//
+// Solidity: function maxValue() view returns(int256)
+func (_OracleIndicator *OracleIndicatorCaller) MaxValue(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "maxValue")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxValue is a free data retrieval call binding the contract method 0x94a5c2e4.
+//
+// Solidity: function maxValue() view returns(int256)
+func (_OracleIndicator *OracleIndicatorSession) MaxValue() (*big.Int, error) {
+	return _OracleIndicator.Contract.MaxValue(&_OracleIndicator.CallOpts)
+}
+
+// MaxValue is a free data retrieval call binding the contract method 0x94a5c2e4.
+//
+// Solidity: function maxValue() view returns(int256)
+func (_OracleIndicator *OracleIndicatorCallerSession) MaxValue() (*big.Int, error) {
+	return _OracleIndicator.Contract.MaxValue(&_OracleIndicator.CallOpts)
+}
+
+// MinValue is a free data retrieval call binding the contract method 0x963e63c7.
+//
+// Solidity: function minValue() view returns(int256)
+func (_OracleIndicator *OracleIndicatorCaller) MinValue(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "minValue")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinValue is a free data retrieval call binding the contract method 0x963e63c7.
+//
+// Solidity: function minValue() view returns(int256)
+func (_OracleIndicator *OracleIndicatorSession) MinValue() (*big.Int, error) {
+	return _OracleIndicator.Contract.MinValue(&_OracleIndicator.CallOpts)
+}
+
+// MinValue is a free data retrieval call binding the contract method 0x963e63c7.
+//
+// Solidity: function minValue() view returns(int256)
+func (_OracleIndicator *OracleIndicatorCallerSession) MinValue() (*big.Int, error) {
+	return _OracleIndicator.Contract.MinValue(&_OracleIndicator.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) Paused() (bool, error) {
+	return _OracleIndicator.Contract.Paused(&_OracleIndicator.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) Paused() (bool, error) {
+	return _OracleIndicator.Contract.Paused(&_OracleIndicator.CallOpts)
+}
+
+// Quorum is a free data retrieval call binding the contract method 0x1703a018.
+//
+// Solidity: function quorum() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCaller) Quorum(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "quorum")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Quorum is a free data retrieval call binding the contract method 0x1703a018.
+//
+// Solidity: function quorum() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorSession) Quorum() (uint8, error) {
+	return _OracleIndicator.Contract.Quorum(&_OracleIndicator.CallOpts)
+}
+
+// Quorum is a free data retrieval call binding the contract method 0x1703a018.
+//
+// Solidity: function quorum() view returns(uint8)
+func (_OracleIndicator *OracleIndicatorCallerSession) Quorum() (uint8, error) {
+	return _OracleIndicator.Contract.Quorum(&_OracleIndicator.CallOpts)
+}
+
+// ReadExpiry is a free data retrieval call binding the contract method 0x55cc207b.
+//
+// Solidity: function readExpiry(address ) view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCaller) ReadExpiry(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "readExpiry", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ReadExpiry is a free data retrieval call binding the contract method 0x55cc207b.
+//
+// Solidity: function readExpiry(address ) view returns(uint256)
+func (_OracleIndicator *OracleIndicatorSession) ReadExpiry(arg0 common.Address) (*big.Int, error) {
+	return _OracleIndicator.Contract.ReadExpiry(&_OracleIndicator.CallOpts, arg0)
+}
+
+// ReadExpiry is a free data retrieval call binding the contract method 0x55cc207b.
+//
+// Solidity: function readExpiry(address ) view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCallerSession) ReadExpiry(arg0 common.Address) (*big.Int, error) {
+	return _OracleIndicator.Contract.ReadExpiry(&_OracleIndicator.CallOpts, arg0)
+}
+
+// ReportDigest is a free data retrieval call binding the contract method 0x9b824d0c.
+//
+// Solidity: function reportDigest(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline) view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCaller) ReportDigest(opts *bind.CallOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "reportDigest", _timestamp, _value, _updatedat, _confidence, _deadline)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ReportDigest is a free data retrieval call binding the contract method 0x9b824d0c.
+//
+// Solidity: function reportDigest(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline) view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorSession) ReportDigest(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int) ([32]byte, error) {
+	return _OracleIndicator.Contract.ReportDigest(&_OracleIndicator.CallOpts, _timestamp, _value, _updatedat, _confidence, _deadline)
+}
+
+// ReportDigest is a free data retrieval call binding the contract method 0x9b824d0c.
+//
+// Solidity: function reportDigest(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline) view returns(bytes32)
+func (_OracleIndicator *OracleIndicatorCallerSession) ReportDigest(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int) ([32]byte, error) {
+	return _OracleIndicator.Contract.ReportDigest(&_OracleIndicator.CallOpts, _timestamp, _value, _updatedat, _confidence, _deadline)
+}
+
+// Retracted is a free data retrieval call binding the contract method 0x9fa2c776.
+//
+// Solidity: function retracted(uint256 ) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) Retracted(opts *bind.CallOpts, arg0 *big.Int) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "retracted", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Retracted is a free data retrieval call binding the contract method 0x9fa2c776.
+//
+// Solidity: function retracted(uint256 ) view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) Retracted(arg0 *big.Int) (bool, error) {
+	return _OracleIndicator.Contract.Retracted(&_OracleIndicator.CallOpts, arg0)
+}
+
+// Retracted is a free data retrieval call binding the contract method 0x9fa2c776.
+//
+// Solidity: function retracted(uint256 ) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) Retracted(arg0 *big.Int) (bool, error) {
+	return _OracleIndicator.Contract.Retracted(&_OracleIndicator.CallOpts, arg0)
+}
+
+// RetractedCount is a free data retrieval call binding the contract method 0xfac62972.
+//
+// Solidity: function retractedCount() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCaller) RetractedCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "retractedCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RetractedCount is a free data retrieval call binding the contract method 0xfac62972.
+//
+// Solidity: function retractedCount() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorSession) RetractedCount() (*big.Int, error) {
+	return _OracleIndicator.Contract.RetractedCount(&_OracleIndicator.CallOpts)
+}
+
+// RetractedCount is a free data retrieval call binding the contract method 0xfac62972.
+//
+// Solidity: function retractedCount() view returns(uint256)
+func (_OracleIndicator *OracleIndicatorCallerSession) RetractedCount() (*big.Int, error) {
+	return _OracleIndicator.Contract.RetractedCount(&_OracleIndicator.CallOpts)
+}
+
+// SubmittedReports is a free data retrieval call binding the contract method 0x3ca956d8.
+//
+// Solidity: function submittedReports(bytes32 , address ) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) SubmittedReports(opts *bind.CallOpts, arg0 [32]byte, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "submittedReports", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SubmittedReports is a free data retrieval call binding the contract method 0x3ca956d8.
+//
+// Solidity: function submittedReports(bytes32 , address ) view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) SubmittedReports(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _OracleIndicator.Contract.SubmittedReports(&_OracleIndicator.CallOpts, arg0, arg1)
+}
+
+// SubmittedReports is a free data retrieval call binding the contract method 0x3ca956d8.
+//
+// Solidity: function submittedReports(bytes32 , address ) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) SubmittedReports(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _OracleIndicator.Contract.SubmittedReports(&_OracleIndicator.CallOpts, arg0, arg1)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicator *OracleIndicatorSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _OracleIndicator.Contract.SupportsInterface(&_OracleIndicator.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicator *OracleIndicatorCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _OracleIndicator.Contract.SupportsInterface(&_OracleIndicator.CallOpts, interfaceId)
+}
+
+// ToleranceBps is a free data retrieval call binding the contract method 0xcd64f6fb.
+//
+// Solidity: function toleranceBps() view returns(uint16)
+func (_OracleIndicator *OracleIndicatorCaller) ToleranceBps(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _OracleIndicator.contract.Call(opts, &out, "toleranceBps")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// ToleranceBps is a free data retrieval call binding the contract method 0xcd64f6fb.
+//
+// Solidity: function toleranceBps() view returns(uint16)
+func (_OracleIndicator *OracleIndicatorSession) ToleranceBps() (uint16, error) {
+	return _OracleIndicator.Contract.ToleranceBps(&_OracleIndicator.CallOpts)
+}
+
+// ToleranceBps is a free data retrieval call binding the contract method 0xcd64f6fb.
+//
+// Solidity: function toleranceBps() view returns(uint16)
+func (_OracleIndicator *OracleIndicatorCallerSession) ToleranceBps() (uint16, error) {
+	return _OracleIndicator.Contract.ToleranceBps(&_OracleIndicator.CallOpts)
+}
+
+// GrantReadAccess is a paid mutator transaction binding the contract method 0x39d80c27.
+//
+// Solidity: function grantReadAccess(address[] _accounts, uint256[] _expiries) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) GrantReadAccess(opts *bind.TransactOpts, _accounts []common.Address, _expiries []*big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "grantReadAccess", _accounts, _expiries)
+}
+
+// GrantReadAccess is a paid mutator transaction binding the contract method 0x39d80c27.
+//
+// Solidity: function grantReadAccess(address[] _accounts, uint256[] _expiries) returns()
+func (_OracleIndicator *OracleIndicatorSession) GrantReadAccess(_accounts []common.Address, _expiries []*big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.GrantReadAccess(&_OracleIndicator.TransactOpts, _accounts, _expiries)
+}
+
+// GrantReadAccess is a paid mutator transaction binding the contract method 0x39d80c27.
+//
+// Solidity: function grantReadAccess(address[] _accounts, uint256[] _expiries) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) GrantReadAccess(_accounts []common.Address, _expiries []*big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.GrantReadAccess(&_OracleIndicator.TransactOpts, _accounts, _expiries)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicator *OracleIndicatorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.GrantRole(&_OracleIndicator.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.GrantRole(&_OracleIndicator.TransactOpts, role, account)
+}
+
+// Invalidate is a paid mutator transaction binding the contract method 0x1ea1afdb.
+//
+// Solidity: function invalidate(uint256 _timestamp) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) Invalidate(opts *bind.TransactOpts, _timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "invalidate", _timestamp)
+}
+
+// Invalidate is a paid mutator transaction binding the contract method 0x1ea1afdb.
+//
+// Solidity: function invalidate(uint256 _timestamp) returns()
+func (_OracleIndicator *OracleIndicatorSession) Invalidate(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Invalidate(&_OracleIndicator.TransactOpts, _timestamp)
+}
+
+// Invalidate is a paid mutator transaction binding the contract method 0x1ea1afdb.
+//
+// Solidity: function invalidate(uint256 _timestamp) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) Invalidate(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Invalidate(&_OracleIndicator.TransactOpts, _timestamp)
+}
+
+// OverrideIndicator is a paid mutator transaction binding the contract method 0xd5c2d6fd.
+//
+// Solidity: function overrideIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) OverrideIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "overrideIndicator", _timestamp, _value, _updatedat, _confidence)
+}
+
+// OverrideIndicator is a paid mutator transaction binding the contract method 0xd5c2d6fd.
+//
+// Solidity: function overrideIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicator *OracleIndicatorSession) OverrideIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.OverrideIndicator(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// OverrideIndicator is a paid mutator transaction binding the contract method 0xd5c2d6fd.
+//
+// Solidity: function overrideIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) OverrideIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.OverrideIndicator(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_OracleIndicator *OracleIndicatorTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_OracleIndicator *OracleIndicatorSession) Pause() (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Pause(&_OracleIndicator.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) Pause() (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Pause(&_OracleIndicator.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicator *OracleIndicatorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.RenounceRole(&_OracleIndicator.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.RenounceRole(&_OracleIndicator.TransactOpts, role, callerConfirmation)
+}
+
+// Restore is a paid mutator transaction binding the contract method 0x9bea62ad.
+//
+// Solidity: function restore(uint256 _timestamp) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) Restore(opts *bind.TransactOpts, _timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "restore", _timestamp)
+}
+
+// Restore is a paid mutator transaction binding the contract method 0x9bea62ad.
+//
+// Solidity: function restore(uint256 _timestamp) returns()
+func (_OracleIndicator *OracleIndicatorSession) Restore(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Restore(&_OracleIndicator.TransactOpts, _timestamp)
+}
+
+// Restore is a paid mutator transaction binding the contract method 0x9bea62ad.
+//
+// Solidity: function restore(uint256 _timestamp) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) Restore(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Restore(&_OracleIndicator.TransactOpts, _timestamp)
+}
+
+// RevokeReadAccess is a paid mutator transaction binding the contract method 0xf2ac3f08.
+//
+// Solidity: function revokeReadAccess(address[] _accounts) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) RevokeReadAccess(opts *bind.TransactOpts, _accounts []common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "revokeReadAccess", _accounts)
+}
+
+// RevokeReadAccess is a paid mutator transaction binding the contract method 0xf2ac3f08.
+//
+// Solidity: function revokeReadAccess(address[] _accounts) returns()
+func (_OracleIndicator *OracleIndicatorSession) RevokeReadAccess(_accounts []common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.RevokeReadAccess(&_OracleIndicator.TransactOpts, _accounts)
+}
+
+// RevokeReadAccess is a paid mutator transaction binding the contract method 0xf2ac3f08.
+//
+// Solidity: function revokeReadAccess(address[] _accounts) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) RevokeReadAccess(_accounts []common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.RevokeReadAccess(&_OracleIndicator.TransactOpts, _accounts)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicator *OracleIndicatorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.RevokeRole(&_OracleIndicator.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.RevokeRole(&_OracleIndicator.TransactOpts, role, account)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) SaveIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "saveIndicator", _timestamp, _value, _updatedat, _confidence)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicator *OracleIndicatorSession) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SaveIndicator(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SaveIndicator(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// SetAccessMode is a paid mutator transaction binding the contract method 0x7e31d2cc.
+//
+// Solidity: function setAccessMode(uint8 _mode) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) SetAccessMode(opts *bind.TransactOpts, _mode uint8) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "setAccessMode", _mode)
+}
+
+// SetAccessMode is a paid mutator transaction binding the contract method 0x7e31d2cc.
+//
+// Solidity: function setAccessMode(uint8 _mode) returns()
+func (_OracleIndicator *OracleIndicatorSession) SetAccessMode(_mode uint8) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SetAccessMode(&_OracleIndicator.TransactOpts, _mode)
+}
+
+// SetAccessMode is a paid mutator transaction binding the contract method 0x7e31d2cc.
+//
+// Solidity: function setAccessMode(uint8 _mode) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) SetAccessMode(_mode uint8) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SetAccessMode(&_OracleIndicator.TransactOpts, _mode)
+}
+
+// SetConsensus is a paid mutator transaction binding the contract method 0x5780f841.
+//
+// Solidity: function setConsensus(uint8 _quorum, uint16 _toleranceBps) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) SetConsensus(opts *bind.TransactOpts, _quorum uint8, _toleranceBps uint16) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "setConsensus", _quorum, _toleranceBps)
+}
+
+// SetConsensus is a paid mutator transaction binding the contract method 0x5780f841.
+//
+// Solidity: function setConsensus(uint8 _quorum, uint16 _toleranceBps) returns()
+func (_OracleIndicator *OracleIndicatorSession) SetConsensus(_quorum uint8, _toleranceBps uint16) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SetConsensus(&_OracleIndicator.TransactOpts, _quorum, _toleranceBps)
+}
+
+// SetConsensus is a paid mutator transaction binding the contract method 0x5780f841.
+//
+// Solidity: function setConsensus(uint8 _quorum, uint16 _toleranceBps) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) SetConsensus(_quorum uint8, _toleranceBps uint16) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SetConsensus(&_OracleIndicator.TransactOpts, _quorum, _toleranceBps)
+}
+
+// SetLimits is a paid mutator transaction binding the contract method 0x8fd92eab.
+//
+// Solidity: function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) SetLimits(opts *bind.TransactOpts, _min *big.Int, _max *big.Int, _maxDeviationBps uint16) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "setLimits", _min, _max, _maxDeviationBps)
+}
+
+// SetLimits is a paid mutator transaction binding the contract method 0x8fd92eab.
+//
+// Solidity: function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) returns()
+func (_OracleIndicator *OracleIndicatorSession) SetLimits(_min *big.Int, _max *big.Int, _maxDeviationBps uint16) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SetLimits(&_OracleIndicator.TransactOpts, _min, _max, _maxDeviationBps)
+}
+
+// SetLimits is a paid mutator transaction binding the contract method 0x8fd92eab.
+//
+// Solidity: function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) SetLimits(_min *big.Int, _max *big.Int, _maxDeviationBps uint16) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SetLimits(&_OracleIndicator.TransactOpts, _min, _max, _maxDeviationBps)
+}
+
+// SubmitSignedIndicator is a paid mutator transaction binding the contract method 0x76aad3fb.
+//
+// Solidity: function submitSignedIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline, bytes _signature) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) SubmitSignedIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int, _signature []byte) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "submitSignedIndicator", _timestamp, _value, _updatedat, _confidence, _deadline, _signature)
+}
+
+// SubmitSignedIndicator is a paid mutator transaction binding the contract method 0x76aad3fb.
+//
+// Solidity: function submitSignedIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline, bytes _signature) returns()
+func (_OracleIndicator *OracleIndicatorSession) SubmitSignedIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int, _signature []byte) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SubmitSignedIndicator(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat, _confidence, _deadline, _signature)
+}
+
+// SubmitSignedIndicator is a paid mutator transaction binding the contract method 0x76aad3fb.
+//
+// Solidity: function submitSignedIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline, bytes _signature) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) SubmitSignedIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int, _signature []byte) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SubmitSignedIndicator(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat, _confidence, _deadline, _signature)
+}
+
+// SubmitValue is a paid mutator transaction binding the contract method 0xbf48027c.
+//
+// Solidity: function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) returns()
+func (_OracleIndicator *OracleIndicatorTransactor) SubmitValue(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "submitValue", _timestamp, _value, _updatedat)
+}
+
+// SubmitValue is a paid mutator transaction binding the contract method 0xbf48027c.
+//
+// Solidity: function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) returns()
+func (_OracleIndicator *OracleIndicatorSession) SubmitValue(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SubmitValue(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat)
+}
+
+// SubmitValue is a paid mutator transaction binding the contract method 0xbf48027c.
+//
+// Solidity: function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) SubmitValue(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int) (*types.Transaction, error) {
+	return _OracleIndicator.Contract.SubmitValue(&_OracleIndicator.TransactOpts, _timestamp, _value, _updatedat)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_OracleIndicator *OracleIndicatorTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicator.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_OracleIndicator *OracleIndicatorSession) Unpause() (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Unpause(&_OracleIndicator.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_OracleIndicator *OracleIndicatorTransactorSession) Unpause() (*types.Transaction, error) {
+	return _OracleIndicator.Contract.Unpause(&_OracleIndicator.TransactOpts)
+}
+
+// OracleIndicatorAccessModeChangedIterator is returned from FilterAccessModeChanged and is used to iterate over the raw logs and unpacked data for AccessModeChanged events raised by the OracleIndicator contract.
+type OracleIndicatorAccessModeChangedIterator struct {
+	Event *OracleIndicatorAccessModeChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorAccessModeChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorAccessModeChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorAccessModeChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorAccessModeChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorAccessModeChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorAccessModeChanged represents a AccessModeChanged event raised by the OracleIndicator contract.
+type OracleIndicatorAccessModeChanged struct {
+	Mode uint8
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterAccessModeChanged is a free log retrieval operation binding the contract event 0x17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56.
+//
+// Solidity: event AccessModeChanged(uint8 mode)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterAccessModeChanged(opts *bind.FilterOpts) (*OracleIndicatorAccessModeChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "AccessModeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorAccessModeChangedIterator{contract: _OracleIndicator.contract, event: "AccessModeChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAccessModeChanged is a free log subscription operation binding the contract event 0x17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56.
+//
+// Solidity: event AccessModeChanged(uint8 mode)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchAccessModeChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorAccessModeChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "AccessModeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorAccessModeChanged)
+				if err := _OracleIndicator.contract.UnpackLog(event, "AccessModeChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAccessModeChanged is a log parse operation binding the contract event 0x17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56.
+//
+// Solidity: event AccessModeChanged(uint8 mode)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseAccessModeChanged(log types.Log) (*OracleIndicatorAccessModeChanged, error) {
+	event := new(OracleIndicatorAccessModeChanged)
+	if err := _OracleIndicator.contract.UnpackLog(event, "AccessModeChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorConsensusChangedIterator is returned from FilterConsensusChanged and is used to iterate over the raw logs and unpacked data for ConsensusChanged events raised by the OracleIndicator contract.
+type OracleIndicatorConsensusChangedIterator struct {
+	Event *OracleIndicatorConsensusChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorConsensusChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorConsensusChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorConsensusChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorConsensusChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorConsensusChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorConsensusChanged represents a ConsensusChanged event raised by the OracleIndicator contract.
+type OracleIndicatorConsensusChanged struct {
+	Quorum       uint8
+	ToleranceBps uint16
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusChanged is a free log retrieval operation binding the contract event 0xfbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556.
+//
+// Solidity: event ConsensusChanged(uint8 quorum, uint16 toleranceBps)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterConsensusChanged(opts *bind.FilterOpts) (*OracleIndicatorConsensusChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "ConsensusChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorConsensusChangedIterator{contract: _OracleIndicator.contract, event: "ConsensusChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusChanged is a free log subscription operation binding the contract event 0xfbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556.
+//
+// Solidity: event ConsensusChanged(uint8 quorum, uint16 toleranceBps)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchConsensusChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorConsensusChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "ConsensusChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorConsensusChanged)
+				if err := _OracleIndicator.contract.UnpackLog(event, "ConsensusChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusChanged is a log parse operation binding the contract event 0xfbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556.
+//
+// Solidity: event ConsensusChanged(uint8 quorum, uint16 toleranceBps)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseConsensusChanged(log types.Log) (*OracleIndicatorConsensusChanged, error) {
+	event := new(OracleIndicatorConsensusChanged)
+	if err := _OracleIndicator.contract.UnpackLog(event, "ConsensusChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorEIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the OracleIndicator contract.
+type OracleIndicatorEIP712DomainChangedIterator struct {
+	Event *OracleIndicatorEIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorEIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorEIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorEIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorEIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorEIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorEIP712DomainChanged represents a EIP712DomainChanged event raised by the OracleIndicator contract.
+type OracleIndicatorEIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_OracleIndicator *OracleIndicatorFilterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*OracleIndicatorEIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorEIP712DomainChangedIterator{contract: _OracleIndicator.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_OracleIndicator *OracleIndicatorFilterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorEIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorEIP712DomainChanged)
+				if err := _OracleIndicator.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_OracleIndicator *OracleIndicatorFilterer) ParseEIP712DomainChanged(log types.Log) (*OracleIndicatorEIP712DomainChanged, error) {
+	event := new(OracleIndicatorEIP712DomainChanged)
+	if err := _OracleIndicator.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorIndicatorInvalidatedIterator is returned from FilterIndicatorInvalidated and is used to iterate over the raw logs and unpacked data for IndicatorInvalidated events raised by the OracleIndicator contract.
+type OracleIndicatorIndicatorInvalidatedIterator struct {
+	Event *OracleIndicatorIndicatorInvalidated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorIndicatorInvalidatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorIndicatorInvalidated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorIndicatorInvalidated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorIndicatorInvalidatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorIndicatorInvalidatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorIndicatorInvalidated represents a IndicatorInvalidated event raised by the OracleIndicator contract.
+type OracleIndicatorIndicatorInvalidated struct {
+	Day     *big.Int
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterIndicatorInvalidated is a free log retrieval operation binding the contract event 0x8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515.
+//
+// Solidity: event IndicatorInvalidated(uint256 indexed day, address account)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterIndicatorInvalidated(opts *bind.FilterOpts, day []*big.Int) (*OracleIndicatorIndicatorInvalidatedIterator, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "IndicatorInvalidated", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorIndicatorInvalidatedIterator{contract: _OracleIndicator.contract, event: "IndicatorInvalidated", logs: logs, sub: sub}, nil
+}
+
+// WatchIndicatorInvalidated is a free log subscription operation binding the contract event 0x8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515.
+//
+// Solidity: event IndicatorInvalidated(uint256 indexed day, address account)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchIndicatorInvalidated(opts *bind.WatchOpts, sink chan<- *OracleIndicatorIndicatorInvalidated, day []*big.Int) (event.Subscription, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "IndicatorInvalidated", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorIndicatorInvalidated)
+				if err := _OracleIndicator.contract.UnpackLog(event, "IndicatorInvalidated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIndicatorInvalidated is a log parse operation binding the contract event 0x8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515.
+//
+// Solidity: event IndicatorInvalidated(uint256 indexed day, address account)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseIndicatorInvalidated(log types.Log) (*OracleIndicatorIndicatorInvalidated, error) {
+	event := new(OracleIndicatorIndicatorInvalidated)
+	if err := _OracleIndicator.contract.UnpackLog(event, "IndicatorInvalidated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorIndicatorRestoredIterator is returned from FilterIndicatorRestored and is used to iterate over the raw logs and unpacked data for IndicatorRestored events raised by the OracleIndicator contract.
+type OracleIndicatorIndicatorRestoredIterator struct {
+	Event *OracleIndicatorIndicatorRestored // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorIndicatorRestoredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorIndicatorRestored)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorIndicatorRestored)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorIndicatorRestoredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorIndicatorRestoredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorIndicatorRestored represents a IndicatorRestored event raised by the OracleIndicator contract.
+type OracleIndicatorIndicatorRestored struct {
+	Day *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterIndicatorRestored is a free log retrieval operation binding the contract event 0x736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4.
+//
+// Solidity: event IndicatorRestored(uint256 indexed day)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterIndicatorRestored(opts *bind.FilterOpts, day []*big.Int) (*OracleIndicatorIndicatorRestoredIterator, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "IndicatorRestored", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorIndicatorRestoredIterator{contract: _OracleIndicator.contract, event: "IndicatorRestored", logs: logs, sub: sub}, nil
+}
+
+// WatchIndicatorRestored is a free log subscription operation binding the contract event 0x736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4.
+//
+// Solidity: event IndicatorRestored(uint256 indexed day)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchIndicatorRestored(opts *bind.WatchOpts, sink chan<- *OracleIndicatorIndicatorRestored, day []*big.Int) (event.Subscription, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "IndicatorRestored", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorIndicatorRestored)
+				if err := _OracleIndicator.contract.UnpackLog(event, "IndicatorRestored", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIndicatorRestored is a log parse operation binding the contract event 0x736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4.
+//
+// Solidity: event IndicatorRestored(uint256 indexed day)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseIndicatorRestored(log types.Log) (*OracleIndicatorIndicatorRestored, error) {
+	event := new(OracleIndicatorIndicatorRestored)
+	if err := _OracleIndicator.contract.UnpackLog(event, "IndicatorRestored", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorLimitsChangedIterator is returned from FilterLimitsChanged and is used to iterate over the raw logs and unpacked data for LimitsChanged events raised by the OracleIndicator contract.
+type OracleIndicatorLimitsChangedIterator struct {
+	Event *OracleIndicatorLimitsChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorLimitsChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorLimitsChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorLimitsChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorLimitsChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorLimitsChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorLimitsChanged represents a LimitsChanged event raised by the OracleIndicator contract.
+type OracleIndicatorLimitsChanged struct {
+	MinValue        *big.Int
+	MaxValue        *big.Int
+	MaxDeviationBps uint16
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterLimitsChanged is a free log retrieval operation binding the contract event 0x42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf46.
+//
+// Solidity: event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterLimitsChanged(opts *bind.FilterOpts) (*OracleIndicatorLimitsChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "LimitsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorLimitsChangedIterator{contract: _OracleIndicator.contract, event: "LimitsChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchLimitsChanged is a free log subscription operation binding the contract event 0x42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf46.
+//
+// Solidity: event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchLimitsChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorLimitsChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "LimitsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorLimitsChanged)
+				if err := _OracleIndicator.contract.UnpackLog(event, "LimitsChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLimitsChanged is a log parse operation binding the contract event 0x42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf46.
+//
+// Solidity: event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseLimitsChanged(log types.Log) (*OracleIndicatorLimitsChanged, error) {
+	event := new(OracleIndicatorLimitsChanged)
+	if err := _OracleIndicator.contract.UnpackLog(event, "LimitsChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorLimitsOverriddenIterator is returned from FilterLimitsOverridden and is used to iterate over the raw logs and unpacked data for LimitsOverridden events raised by the OracleIndicator contract.
+type OracleIndicatorLimitsOverriddenIterator struct {
+	Event *OracleIndicatorLimitsOverridden // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorLimitsOverriddenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorLimitsOverridden)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorLimitsOverridden)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorLimitsOverriddenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorLimitsOverriddenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorLimitsOverridden represents a LimitsOverridden event raised by the OracleIndicator contract.
+type OracleIndicatorLimitsOverridden struct {
+	Day     *big.Int
+	Value   *big.Int
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterLimitsOverridden is a free log retrieval operation binding the contract event 0x773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986.
+//
+// Solidity: event LimitsOverridden(uint256 indexed day, int256 value, address account)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterLimitsOverridden(opts *bind.FilterOpts, day []*big.Int) (*OracleIndicatorLimitsOverriddenIterator, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "LimitsOverridden", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorLimitsOverriddenIterator{contract: _OracleIndicator.contract, event: "LimitsOverridden", logs: logs, sub: sub}, nil
+}
+
+// WatchLimitsOverridden is a free log subscription operation binding the contract event 0x773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986.
+//
+// Solidity: event LimitsOverridden(uint256 indexed day, int256 value, address account)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchLimitsOverridden(opts *bind.WatchOpts, sink chan<- *OracleIndicatorLimitsOverridden, day []*big.Int) (event.Subscription, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "LimitsOverridden", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorLimitsOverridden)
+				if err := _OracleIndicator.contract.UnpackLog(event, "LimitsOverridden", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLimitsOverridden is a log parse operation binding the contract event 0x773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986.
+//
+// Solidity: event LimitsOverridden(uint256 indexed day, int256 value, address account)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseLimitsOverridden(log types.Log) (*OracleIndicatorLimitsOverridden, error) {
+	event := new(OracleIndicatorLimitsOverridden)
+	if err := _OracleIndicator.contract.UnpackLog(event, "LimitsOverridden", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the OracleIndicator contract.
+type OracleIndicatorPausedIterator struct {
+	Event *OracleIndicatorPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorPaused represents a Paused event raised by the OracleIndicator contract.
+type OracleIndicatorPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterPaused(opts *bind.FilterOpts) (*OracleIndicatorPausedIterator, error) {
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorPausedIterator{contract: _OracleIndicator.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *OracleIndicatorPaused) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorPaused)
+				if err := _OracleIndicator.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_OracleIndicator *OracleIndicatorFilterer) ParsePaused(log types.Log) (*OracleIndicatorPaused, error) {
+	event := new(OracleIndicatorPaused)
+	if err := _OracleIndicator.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorReadAccessGrantedIterator is returned from FilterReadAccessGranted and is used to iterate over the raw logs and unpacked data for ReadAccessGranted events raised by the OracleIndicator contract.
+type OracleIndicatorReadAccessGrantedIterator struct {
+	Event *OracleIndicatorReadAccessGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorReadAccessGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorReadAccessGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorReadAccessGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorReadAccessGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorReadAccessGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorReadAccessGranted represents a ReadAccessGranted event raised by the OracleIndicator contract.
+type OracleIndicatorReadAccessGranted struct {
+	Account   common.Address
+	ExpiresAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterReadAccessGranted is a free log retrieval operation binding the contract event 0x4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0.
+//
+// Solidity: event ReadAccessGranted(address indexed account, uint256 expiresAt)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterReadAccessGranted(opts *bind.FilterOpts, account []common.Address) (*OracleIndicatorReadAccessGrantedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "ReadAccessGranted", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorReadAccessGrantedIterator{contract: _OracleIndicator.contract, event: "ReadAccessGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchReadAccessGranted is a free log subscription operation binding the contract event 0x4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0.
+//
+// Solidity: event ReadAccessGranted(address indexed account, uint256 expiresAt)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchReadAccessGranted(opts *bind.WatchOpts, sink chan<- *OracleIndicatorReadAccessGranted, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "ReadAccessGranted", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorReadAccessGranted)
+				if err := _OracleIndicator.contract.UnpackLog(event, "ReadAccessGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+// ParseReadAccessGranted is a log parse operation binding the contract event 0x4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0.
 //
-// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
-func (_Api *ApiCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
-	var out []interface{}
-	err := _Api.contract.Call(opts, &out, "supportsInterface", interfaceId)
-
-	if err != nil {
-		return *new(bool), err
+// Solidity: event ReadAccessGranted(address indexed account, uint256 expiresAt)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseReadAccessGranted(log types.Log) (*OracleIndicatorReadAccessGranted, error) {
+	event := new(OracleIndicatorReadAccessGranted)
+	if err := _OracleIndicator.contract.UnpackLog(event, "ReadAccessGranted", log); err != nil {
+		return nil, err
 	}
+	event.Raw = log
+	return event, nil
+}
 
-	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+// OracleIndicatorReadAccessRevokedIterator is returned from FilterReadAccessRevoked and is used to iterate over the raw logs and unpacked data for ReadAccessRevoked events raised by the OracleIndicator contract.
+type OracleIndicatorReadAccessRevokedIterator struct {
+	Event *OracleIndicatorReadAccessRevoked // Event containing the contract specifics and raw log
 
-	return out0, err
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
 
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
 }
 
-// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
-//
-// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
-func (_Api *ApiSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
-	return _Api.Contract.SupportsInterface(&_Api.CallOpts, interfaceId)
-}
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorReadAccessRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorReadAccessRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
 
-// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
-//
-// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
-func (_Api *ApiCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
-	return _Api.Contract.SupportsInterface(&_Api.CallOpts, interfaceId)
-}
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorReadAccessRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
 
-// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
-//
-// Solidity: function grantRole(bytes32 role, address account) returns()
-func (_Api *ApiTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
-	return _Api.contract.Transact(opts, "grantRole", role, account)
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
 }
 
-// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
-//
-// Solidity: function grantRole(bytes32 role, address account) returns()
-func (_Api *ApiSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
-	return _Api.Contract.GrantRole(&_Api.TransactOpts, role, account)
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorReadAccessRevokedIterator) Error() error {
+	return it.fail
 }
 
-// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
-//
-// Solidity: function grantRole(bytes32 role, address account) returns()
-func (_Api *ApiTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
-	return _Api.Contract.GrantRole(&_Api.TransactOpts, role, account)
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorReadAccessRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
 }
 
-// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
-//
-// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
-func (_Api *ApiTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
-	return _Api.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+// OracleIndicatorReadAccessRevoked represents a ReadAccessRevoked event raised by the OracleIndicator contract.
+type OracleIndicatorReadAccessRevoked struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+// FilterReadAccessRevoked is a free log retrieval operation binding the contract event 0x0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec.
 //
-// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
-func (_Api *ApiSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
-	return _Api.Contract.RenounceRole(&_Api.TransactOpts, role, callerConfirmation)
-}
+// Solidity: event ReadAccessRevoked(address indexed account)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterReadAccessRevoked(opts *bind.FilterOpts, account []common.Address) (*OracleIndicatorReadAccessRevokedIterator, error) {
 
-// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
-//
-// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
-func (_Api *ApiTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
-	return _Api.Contract.RenounceRole(&_Api.TransactOpts, role, callerConfirmation)
-}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
 
-// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
-//
-// Solidity: function revokeRole(bytes32 role, address account) returns()
-func (_Api *ApiTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
-	return _Api.contract.Transact(opts, "revokeRole", role, account)
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "ReadAccessRevoked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorReadAccessRevokedIterator{contract: _OracleIndicator.contract, event: "ReadAccessRevoked", logs: logs, sub: sub}, nil
 }
 
-// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+// WatchReadAccessRevoked is a free log subscription operation binding the contract event 0x0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec.
 //
-// Solidity: function revokeRole(bytes32 role, address account) returns()
-func (_Api *ApiSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
-	return _Api.Contract.RevokeRole(&_Api.TransactOpts, role, account)
-}
+// Solidity: event ReadAccessRevoked(address indexed account)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchReadAccessRevoked(opts *bind.WatchOpts, sink chan<- *OracleIndicatorReadAccessRevoked, account []common.Address) (event.Subscription, error) {
 
-// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
-//
-// Solidity: function revokeRole(bytes32 role, address account) returns()
-func (_Api *ApiTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
-	return _Api.Contract.RevokeRole(&_Api.TransactOpts, role, account)
-}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
 
-// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
-//
-// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
-func (_Api *ApiTransactor) SaveIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
-	return _Api.contract.Transact(opts, "saveIndicator", _timestamp, _value, _updatedat, _confidence)
-}
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "ReadAccessRevoked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorReadAccessRevoked)
+				if err := _OracleIndicator.contract.UnpackLog(event, "ReadAccessRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
 
-// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
-//
-// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
-func (_Api *ApiSession) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
-	return _Api.Contract.SaveIndicator(&_Api.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+// ParseReadAccessRevoked is a log parse operation binding the contract event 0x0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec.
 //
-// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
-func (_Api *ApiTransactorSession) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
-	return _Api.Contract.SaveIndicator(&_Api.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+// Solidity: event ReadAccessRevoked(address indexed account)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseReadAccessRevoked(log types.Log) (*OracleIndicatorReadAccessRevoked, error) {
+	event := new(OracleIndicatorReadAccessRevoked)
+	if err := _OracleIndicator.contract.UnpackLog(event, "ReadAccessRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
 }
 
-// ApiRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the Api contract.
-type ApiRoleAdminChangedIterator struct {
-	Event *ApiRoleAdminChanged // Event containing the contract specifics and raw log
+// OracleIndicatorRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the OracleIndicator contract.
+type OracleIndicatorRoleAdminChangedIterator struct {
+	Event *OracleIndicatorRoleAdminChanged // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -653,7 +3155,7 @@ type ApiRoleAdminChangedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ApiRoleAdminChangedIterator) Next() bool {
+func (it *OracleIndicatorRoleAdminChangedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -662,7 +3164,7 @@ func (it *ApiRoleAdminChangedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ApiRoleAdminChanged)
+			it.Event = new(OracleIndicatorRoleAdminChanged)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -677,7 +3179,7 @@ func (it *ApiRoleAdminChangedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ApiRoleAdminChanged)
+		it.Event = new(OracleIndicatorRoleAdminChanged)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -693,19 +3195,19 @@ func (it *ApiRoleAdminChangedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ApiRoleAdminChangedIterator) Error() error {
+func (it *OracleIndicatorRoleAdminChangedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ApiRoleAdminChangedIterator) Close() error {
+func (it *OracleIndicatorRoleAdminChangedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ApiRoleAdminChanged represents a RoleAdminChanged event raised by the Api contract.
-type ApiRoleAdminChanged struct {
+// OracleIndicatorRoleAdminChanged represents a RoleAdminChanged event raised by the OracleIndicator contract.
+type OracleIndicatorRoleAdminChanged struct {
 	Role              [32]byte
 	PreviousAdminRole [32]byte
 	NewAdminRole      [32]byte
@@ -715,7 +3217,7 @@ type ApiRoleAdminChanged struct {
 // FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
 //
 // Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
-func (_Api *ApiFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*ApiRoleAdminChangedIterator, error) {
+func (_OracleIndicator *OracleIndicatorFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*OracleIndicatorRoleAdminChangedIterator, error) {
 
 	var roleRule []interface{}
 	for _, roleItem := range role {
@@ -730,17 +3232,17 @@ func (_Api *ApiFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][3
 		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
 	}
 
-	logs, sub, err := _Api.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
 	if err != nil {
 		return nil, err
 	}
-	return &ApiRoleAdminChangedIterator{contract: _Api.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+	return &OracleIndicatorRoleAdminChangedIterator{contract: _OracleIndicator.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
 }
 
 // WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
 //
 // Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
-func (_Api *ApiFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *ApiRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+func (_OracleIndicator *OracleIndicatorFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
 
 	var roleRule []interface{}
 	for _, roleItem := range role {
@@ -755,7 +3257,7 @@ func (_Api *ApiFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<-
 		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
 	}
 
-	logs, sub, err := _Api.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
 	if err != nil {
 		return nil, err
 	}
@@ -765,8 +3267,8 @@ func (_Api *ApiFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<-
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ApiRoleAdminChanged)
-				if err := _Api.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+				event := new(OracleIndicatorRoleAdminChanged)
+				if err := _OracleIndicator.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -790,18 +3292,18 @@ func (_Api *ApiFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<-
 // ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
 //
 // Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
-func (_Api *ApiFilterer) ParseRoleAdminChanged(log types.Log) (*ApiRoleAdminChanged, error) {
-	event := new(ApiRoleAdminChanged)
-	if err := _Api.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+func (_OracleIndicator *OracleIndicatorFilterer) ParseRoleAdminChanged(log types.Log) (*OracleIndicatorRoleAdminChanged, error) {
+	event := new(OracleIndicatorRoleAdminChanged)
+	if err := _OracleIndicator.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ApiRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the Api contract.
-type ApiRoleGrantedIterator struct {
-	Event *ApiRoleGranted // Event containing the contract specifics and raw log
+// OracleIndicatorRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the OracleIndicator contract.
+type OracleIndicatorRoleGrantedIterator struct {
+	Event *OracleIndicatorRoleGranted // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -815,7 +3317,7 @@ type ApiRoleGrantedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ApiRoleGrantedIterator) Next() bool {
+func (it *OracleIndicatorRoleGrantedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -824,7 +3326,7 @@ func (it *ApiRoleGrantedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ApiRoleGranted)
+			it.Event = new(OracleIndicatorRoleGranted)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -839,7 +3341,7 @@ func (it *ApiRoleGrantedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ApiRoleGranted)
+		it.Event = new(OracleIndicatorRoleGranted)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -855,19 +3357,19 @@ func (it *ApiRoleGrantedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ApiRoleGrantedIterator) Error() error {
+func (it *OracleIndicatorRoleGrantedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ApiRoleGrantedIterator) Close() error {
+func (it *OracleIndicatorRoleGrantedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ApiRoleGranted represents a RoleGranted event raised by the Api contract.
-type ApiRoleGranted struct {
+// OracleIndicatorRoleGranted represents a RoleGranted event raised by the OracleIndicator contract.
+type OracleIndicatorRoleGranted struct {
 	Role    [32]byte
 	Account common.Address
 	Sender  common.Address
@@ -877,7 +3379,7 @@ type ApiRoleGranted struct {
 // FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
 //
 // Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
-func (_Api *ApiFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ApiRoleGrantedIterator, error) {
+func (_OracleIndicator *OracleIndicatorFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*OracleIndicatorRoleGrantedIterator, error) {
 
 	var roleRule []interface{}
 	for _, roleItem := range role {
@@ -892,17 +3394,17 @@ func (_Api *ApiFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byt
 		senderRule = append(senderRule, senderItem)
 	}
 
-	logs, sub, err := _Api.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
 	if err != nil {
 		return nil, err
 	}
-	return &ApiRoleGrantedIterator{contract: _Api.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+	return &OracleIndicatorRoleGrantedIterator{contract: _OracleIndicator.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
 }
 
 // WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
 //
 // Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
-func (_Api *ApiFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *ApiRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+func (_OracleIndicator *OracleIndicatorFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *OracleIndicatorRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
 
 	var roleRule []interface{}
 	for _, roleItem := range role {
@@ -917,7 +3419,7 @@ func (_Api *ApiFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *Api
 		senderRule = append(senderRule, senderItem)
 	}
 
-	logs, sub, err := _Api.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
 	if err != nil {
 		return nil, err
 	}
@@ -927,8 +3429,8 @@ func (_Api *ApiFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *Api
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ApiRoleGranted)
-				if err := _Api.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+				event := new(OracleIndicatorRoleGranted)
+				if err := _OracleIndicator.contract.UnpackLog(event, "RoleGranted", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -952,18 +3454,18 @@ func (_Api *ApiFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *Api
 // ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
 //
 // Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
-func (_Api *ApiFilterer) ParseRoleGranted(log types.Log) (*ApiRoleGranted, error) {
-	event := new(ApiRoleGranted)
-	if err := _Api.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+func (_OracleIndicator *OracleIndicatorFilterer) ParseRoleGranted(log types.Log) (*OracleIndicatorRoleGranted, error) {
+	event := new(OracleIndicatorRoleGranted)
+	if err := _OracleIndicator.contract.UnpackLog(event, "RoleGranted", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ApiRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the Api contract.
-type ApiRoleRevokedIterator struct {
-	Event *ApiRoleRevoked // Event containing the contract specifics and raw log
+// OracleIndicatorRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the OracleIndicator contract.
+type OracleIndicatorRoleRevokedIterator struct {
+	Event *OracleIndicatorRoleRevoked // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -977,7 +3479,7 @@ type ApiRoleRevokedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ApiRoleRevokedIterator) Next() bool {
+func (it *OracleIndicatorRoleRevokedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -986,7 +3488,7 @@ func (it *ApiRoleRevokedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ApiRoleRevoked)
+			it.Event = new(OracleIndicatorRoleRevoked)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1001,7 +3503,7 @@ func (it *ApiRoleRevokedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ApiRoleRevoked)
+		it.Event = new(OracleIndicatorRoleRevoked)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1017,19 +3519,19 @@ func (it *ApiRoleRevokedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ApiRoleRevokedIterator) Error() error {
+func (it *OracleIndicatorRoleRevokedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ApiRoleRevokedIterator) Close() error {
+func (it *OracleIndicatorRoleRevokedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ApiRoleRevoked represents a RoleRevoked event raised by the Api contract.
-type ApiRoleRevoked struct {
+// OracleIndicatorRoleRevoked represents a RoleRevoked event raised by the OracleIndicator contract.
+type OracleIndicatorRoleRevoked struct {
 	Role    [32]byte
 	Account common.Address
 	Sender  common.Address
@@ -1039,7 +3541,7 @@ type ApiRoleRevoked struct {
 // FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
 //
 // Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
-func (_Api *ApiFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ApiRoleRevokedIterator, error) {
+func (_OracleIndicator *OracleIndicatorFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*OracleIndicatorRoleRevokedIterator, error) {
 
 	var roleRule []interface{}
 	for _, roleItem := range role {
@@ -1054,17 +3556,17 @@ func (_Api *ApiFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byt
 		senderRule = append(senderRule, senderItem)
 	}
 
-	logs, sub, err := _Api.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
 	if err != nil {
 		return nil, err
 	}
-	return &ApiRoleRevokedIterator{contract: _Api.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+	return &OracleIndicatorRoleRevokedIterator{contract: _OracleIndicator.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
 }
 
 // WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
 //
 // Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
-func (_Api *ApiFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *ApiRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+func (_OracleIndicator *OracleIndicatorFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *OracleIndicatorRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
 
 	var roleRule []interface{}
 	for _, roleItem := range role {
@@ -1079,7 +3581,7 @@ func (_Api *ApiFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *Api
 		senderRule = append(senderRule, senderItem)
 	}
 
-	logs, sub, err := _Api.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1089,8 +3591,8 @@ func (_Api *ApiFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *Api
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ApiRoleRevoked)
-				if err := _Api.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+				event := new(OracleIndicatorRoleRevoked)
+				if err := _OracleIndicator.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1114,9 +3616,143 @@ func (_Api *ApiFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *Api
 // ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
 //
 // Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
-func (_Api *ApiFilterer) ParseRoleRevoked(log types.Log) (*ApiRoleRevoked, error) {
-	event := new(ApiRoleRevoked)
-	if err := _Api.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+func (_OracleIndicator *OracleIndicatorFilterer) ParseRoleRevoked(log types.Log) (*OracleIndicatorRoleRevoked, error) {
+	event := new(OracleIndicatorRoleRevoked)
+	if err := _OracleIndicator.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the OracleIndicator contract.
+type OracleIndicatorUnpausedIterator struct {
+	Event *OracleIndicatorUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorUnpaused represents a Unpaused event raised by the OracleIndicator contract.
+type OracleIndicatorUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_OracleIndicator *OracleIndicatorFilterer) FilterUnpaused(opts *bind.FilterOpts) (*OracleIndicatorUnpausedIterator, error) {
+
+	logs, sub, err := _OracleIndicator.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorUnpausedIterator{contract: _OracleIndicator.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_OracleIndicator *OracleIndicatorFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *OracleIndicatorUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicator.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorUnpaused)
+				if err := _OracleIndicator.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_OracleIndicator *OracleIndicatorFilterer) ParseUnpaused(log types.Log) (*OracleIndicatorUnpaused, error) {
+	event := new(OracleIndicatorUnpaused)
+	if err := _OracleIndicator.contract.UnpackLog(event, "Unpaused", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -0,0 +1,478 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package api
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// OracleIndicatorAggregatorMetaData contains all meta data concerning the OracleIndicatorAggregator contract.
+var OracleIndicatorAggregatorMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractOracleIndicator\",\"name\":\"_oracle\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"}],\"name\":\"NoDataPresent\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"ReadAccessDenied\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"VERSION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"description\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint80\",\"name\":\"_roundId\",\"type\":\"uint80\"}],\"name\":\"getRoundData\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"},{\"internalType\":\"int256\",\"name\":\"answer\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint80\",\"name\":\"answeredInRound\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"latestRoundData\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"},{\"internalType\":\"int256\",\"name\":\"answer\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint80\",\"name\":\"answeredInRound\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"oracle\",\"outputs\":[{\"internalType\":\"contractOracleIndicator\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
+	Bin: "0x60a060405234801561000f575f80fd5b506040516108d53803806108d583398101604081905261002e9161003f565b6001600160a01b031660805261006c565b5f6020828403121561004f575f80fd5b81516001600160a01b0381168114610065575f80fd5b9392505050565b6080516108286100ad5f395f818160c80152818161015c015281816101e30152818161027b0152818161038b01528181610438015261050801526108285ff3fe608060405234801561000f575f80fd5b506004361061007a575f3560e01c80637dc0d1d0116100585780637dc0d1d0146100c35780639a6fc8f514610102578063feaf968c14610149578063ffa1ad7414610151575f80fd5b8063313ce5671461007e57806354fd4d501461009d5780637284e416146100ae575b5f80fd5b610086610159565b60405160ff90911681526020015b60405180910390f35b60015b604051908152602001610094565b6100b66101df565b60405161009491906105bc565b6100ea7f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b039091168152602001610094565b6101156101103660046105ee565b610263565b604080516001600160501b03968716815260208101959095528401929092526060830152909116608082015260a001610094565b61011561037b565b6100a0600181565b5f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166376809ce36040518163ffffffff1660e01b8152600401602060405180830381865afa1580156101b6573d5f803e3d5ffd5b505050506040513d601f19601f820116820180604052508101906101da9190610630565b905090565b60607f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166317d7de7c6040518163ffffffff1660e01b81526004015f60405180830381865afa15801561023c573d5f803e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526101da919081019061065d565b5f805f805f6102706104f3565b5f6001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016632b57298b6102b66001600160501b038a1662015180610705565b6040518263ffffffff1660e01b81526004016102d491815260200190565b608060405180830381865afa1580156102ef573d5f803e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610313919061072e565b905080602001515f036103495760405163ebb8bb1f60e01b81526001600160501b03881660048201526024015b60405180910390fd5b805187906103636001600160501b03831662015180610705565b60209093015191999098929750909550909350915050565b5f805f805f6103886104f3565b5f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316634d6228316040518163ffffffff1660e01b8152600401608060405180830381865afa1580156103e5573d5f803e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610409919061072e565b905080602001515f036104315760405163ebb8bb1f60e01b81525f6004820152602401610340565b5f620151807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316636b0c932d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610492573d5f803e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104b6919061079d565b6104c091906107b4565b825190915081906104dd6001600160501b03831662015180610705565b6020909401519199909850929650945092509050565b6040516342087d4f60e01b81523360048201527f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316906342087d4f90602401602060405180830381865afa158015610555573d5f803e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061057991906107d3565b61059857604051632b33ab0560e01b8152336004820152602401610340565b565b5f5b838110156105b457818101518382015260200161059c565b50505f910152565b602081525f82518060208401526105da81604085016020870161059a565b601f01601f19169190910160400192915050565b5f602082840312156105fe575f80fd5b81356001600160501b0381168114610614575f80fd5b9392505050565b805160ff8116811461062b575f80fd5b919050565b5f60208284031215610640575f80fd5b6106148261061b565b634e487b7160e01b5f52604160045260245ffd5b5f6020828403121561066d575f80fd5b815167ffffffffffffffff80821115610684575f80fd5b818401915084601f830112610697575f80fd5b8151818111156106a9576106a9610649565b604051601f8201601f19908116603f011681019083821181831017156106d1576106d1610649565b816040528281528760208487010111156106e9575f80fd5b6106fa83602083016020880161059a565b979650505050505050565b808202811582820484141761072857634e487b7160e01b5f52601160045260245ffd5b92915050565b5f6080828403121561073e575f80fd5b6040516080810181811067ffffffffffffffff8211171561076157610761610649565b806040525082518152602083015160208201526107806040840161061b565b60408201526107916060840161061b565b60608201529392505050565b5f602082840312156107ad575f80fd5b5051919050565b5f826107ce57634e487b7160e01b5f52601260045260245ffd5b500490565b5f602082840312156107e3575f80fd5b81518015158114610614575f80fdfea26469706673582212204fcc9f1690ff3fe63eed6fdb2dceee439f6574b62e06797f2deb413e8665c49f64736f6c63430008150033",
+}
+
+// OracleIndicatorAggregatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use OracleIndicatorAggregatorMetaData.ABI instead.
+var OracleIndicatorAggregatorABI = OracleIndicatorAggregatorMetaData.ABI
+
+// OracleIndicatorAggregatorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use OracleIndicatorAggregatorMetaData.Bin instead.
+var OracleIndicatorAggregatorBin = OracleIndicatorAggregatorMetaData.Bin
+
+// DeployOracleIndicatorAggregator deploys a new Ethereum contract, binding an instance of OracleIndicatorAggregator to it.
+func DeployOracleIndicatorAggregator(auth *bind.TransactOpts, backend bind.ContractBackend, _oracle common.Address) (common.Address, *types.Transaction, *OracleIndicatorAggregator, error) {
+	parsed, err := OracleIndicatorAggregatorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(OracleIndicatorAggregatorBin), backend, _oracle)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &OracleIndicatorAggregator{OracleIndicatorAggregatorCaller: OracleIndicatorAggregatorCaller{contract: contract}, OracleIndicatorAggregatorTransactor: OracleIndicatorAggregatorTransactor{contract: contract}, OracleIndicatorAggregatorFilterer: OracleIndicatorAggregatorFilterer{contract: contract}}, nil
+}
+
+// OracleIndicatorAggregator is an auto generated Go binding around an Ethereum contract.
+type OracleIndicatorAggregator struct {
+	OracleIndicatorAggregatorCaller     // Read-only binding to the contract
+	OracleIndicatorAggregatorTransactor // Write-only binding to the contract
+	OracleIndicatorAggregatorFilterer   // Log filterer for contract events
+}
+
+// OracleIndicatorAggregatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OracleIndicatorAggregatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorAggregatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OracleIndicatorAggregatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorAggregatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OracleIndicatorAggregatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorAggregatorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OracleIndicatorAggregatorSession struct {
+	Contract     *OracleIndicatorAggregator // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorAggregatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OracleIndicatorAggregatorCallerSession struct {
+	Contract *OracleIndicatorAggregatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// OracleIndicatorAggregatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OracleIndicatorAggregatorTransactorSession struct {
+	Contract     *OracleIndicatorAggregatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorAggregatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OracleIndicatorAggregatorRaw struct {
+	Contract *OracleIndicatorAggregator // Generic contract binding to access the raw methods on
+}
+
+// OracleIndicatorAggregatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OracleIndicatorAggregatorCallerRaw struct {
+	Contract *OracleIndicatorAggregatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OracleIndicatorAggregatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OracleIndicatorAggregatorTransactorRaw struct {
+	Contract *OracleIndicatorAggregatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOracleIndicatorAggregator creates a new instance of OracleIndicatorAggregator, bound to a specific deployed contract.
+func NewOracleIndicatorAggregator(address common.Address, backend bind.ContractBackend) (*OracleIndicatorAggregator, error) {
+	contract, err := bindOracleIndicatorAggregator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorAggregator{OracleIndicatorAggregatorCaller: OracleIndicatorAggregatorCaller{contract: contract}, OracleIndicatorAggregatorTransactor: OracleIndicatorAggregatorTransactor{contract: contract}, OracleIndicatorAggregatorFilterer: OracleIndicatorAggregatorFilterer{contract: contract}}, nil
+}
+
+// NewOracleIndicatorAggregatorCaller creates a new read-only instance of OracleIndicatorAggregator, bound to a specific deployed contract.
+func NewOracleIndicatorAggregatorCaller(address common.Address, caller bind.ContractCaller) (*OracleIndicatorAggregatorCaller, error) {
+	contract, err := bindOracleIndicatorAggregator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorAggregatorCaller{contract: contract}, nil
+}
+
+// NewOracleIndicatorAggregatorTransactor creates a new write-only instance of OracleIndicatorAggregator, bound to a specific deployed contract.
+func NewOracleIndicatorAggregatorTransactor(address common.Address, transactor bind.ContractTransactor) (*OracleIndicatorAggregatorTransactor, error) {
+	contract, err := bindOracleIndicatorAggregator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorAggregatorTransactor{contract: contract}, nil
+}
+
+// NewOracleIndicatorAggregatorFilterer creates a new log filterer instance of OracleIndicatorAggregator, bound to a specific deployed contract.
+func NewOracleIndicatorAggregatorFilterer(address common.Address, filterer bind.ContractFilterer) (*OracleIndicatorAggregatorFilterer, error) {
+	contract, err := bindOracleIndicatorAggregator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorAggregatorFilterer{contract: contract}, nil
+}
+
+// bindOracleIndicatorAggregator binds a generic wrapper to an already deployed contract.
+func bindOracleIndicatorAggregator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OracleIndicatorAggregatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorAggregator.Contract.OracleIndicatorAggregatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorAggregator.Contract.OracleIndicatorAggregatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorAggregator.Contract.OracleIndicatorAggregatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorAggregator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorAggregator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorAggregator.Contract.contract.Transact(opts, method, params...)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint256)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) VERSION(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "VERSION")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint256)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) VERSION() (*big.Int, error) {
+	return _OracleIndicatorAggregator.Contract.VERSION(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint256)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) VERSION() (*big.Int, error) {
+	return _OracleIndicatorAggregator.Contract.VERSION(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) Decimals() (uint8, error) {
+	return _OracleIndicatorAggregator.Contract.Decimals(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) Decimals() (uint8, error) {
+	return _OracleIndicatorAggregator.Contract.Decimals(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) Description(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "description")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) Description() (string, error) {
+	return _OracleIndicatorAggregator.Contract.Description(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) Description() (string, error) {
+	return _OracleIndicatorAggregator.Contract.Description(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// GetRoundData is a free data retrieval call binding the contract method 0x9a6fc8f5.
+//
+// Solidity: function getRoundData(uint80 _roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) GetRoundData(opts *bind.CallOpts, _roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "getRoundData", _roundId)
+
+	outstruct := new(struct {
+		RoundId         *big.Int
+		Answer          *big.Int
+		StartedAt       *big.Int
+		UpdatedAt       *big.Int
+		AnsweredInRound *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RoundId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Answer = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.UpdatedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.AnsweredInRound = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetRoundData is a free data retrieval call binding the contract method 0x9a6fc8f5.
+//
+// Solidity: function getRoundData(uint80 _roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) GetRoundData(_roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _OracleIndicatorAggregator.Contract.GetRoundData(&_OracleIndicatorAggregator.CallOpts, _roundId)
+}
+
+// GetRoundData is a free data retrieval call binding the contract method 0x9a6fc8f5.
+//
+// Solidity: function getRoundData(uint80 _roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) GetRoundData(_roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _OracleIndicatorAggregator.Contract.GetRoundData(&_OracleIndicatorAggregator.CallOpts, _roundId)
+}
+
+// LatestRoundData is a free data retrieval call binding the contract method 0xfeaf968c.
+//
+// Solidity: function latestRoundData() view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) LatestRoundData(opts *bind.CallOpts) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "latestRoundData")
+
+	outstruct := new(struct {
+		RoundId         *big.Int
+		Answer          *big.Int
+		StartedAt       *big.Int
+		UpdatedAt       *big.Int
+		AnsweredInRound *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RoundId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Answer = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.UpdatedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.AnsweredInRound = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// LatestRoundData is a free data retrieval call binding the contract method 0xfeaf968c.
+//
+// Solidity: function latestRoundData() view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) LatestRoundData() (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _OracleIndicatorAggregator.Contract.LatestRoundData(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// LatestRoundData is a free data retrieval call binding the contract method 0xfeaf968c.
+//
+// Solidity: function latestRoundData() view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) LatestRoundData() (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _OracleIndicatorAggregator.Contract.LatestRoundData(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) Oracle(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "oracle")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) Oracle() (common.Address, error) {
+	return _OracleIndicatorAggregator.Contract.Oracle(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) Oracle() (common.Address, error) {
+	return _OracleIndicatorAggregator.Contract.Oracle(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint256)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCaller) Version(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorAggregator.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint256)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorSession) Version() (*big.Int, error) {
+	return _OracleIndicatorAggregator.Contract.Version(&_OracleIndicatorAggregator.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint256)
+func (_OracleIndicatorAggregator *OracleIndicatorAggregatorCallerSession) Version() (*big.Int, error) {
+	return _OracleIndicatorAggregator.Contract.Version(&_OracleIndicatorAggregator.CallOpts)
+}
@@ -0,0 +1,368 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package api
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// OracleIndicatorProxyMetaData contains all meta data concerning the OracleIndicatorProxy contract.
+var OracleIndicatorProxyMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_implementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedInnerCall\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"}]",
+	Bin: "0x608060405234801561000f575f80fd5b5060405161040038038061040083398101604081905261002e9161026e565b818161003a8282610043565b50505050610351565b61004c826100a1565b6040516001600160a01b038316907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b905f90a280511561009557610090828261011c565b505050565b61009d61018f565b5050565b806001600160a01b03163b5f036100db57604051634c9c8ce360e01b81526001600160a01b03821660048201526024015b60405180910390fd5b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc80546001600160a01b0319166001600160a01b0392909216919091179055565b60605f80846001600160a01b0316846040516101389190610336565b5f60405180830381855af49150503d805f8114610170576040519150601f19603f3d011682016040523d82523d5f602084013e610175565b606091505b5090925090506101868583836101b0565b95945050505050565b34156101ae5760405163b398979f60e01b815260040160405180910390fd5b565b6060826101c5576101c08261020f565b610208565b81511580156101dc57506001600160a01b0384163b155b1561020557604051639996b31560e01b81526001600160a01b03851660048201526024016100d2565b50805b9392505050565b80511561021f5780518082602001fd5b604051630a12f52160e11b815260040160405180910390fd5b634e487b7160e01b5f52604160045260245ffd5b5f5b8381101561026657818101518382015260200161024e565b50505f910152565b5f806040838503121561027f575f80fd5b82516001600160a01b0381168114610295575f80fd5b60208401519092506001600160401b03808211156102b1575f80fd5b818501915085601f8301126102c4575f80fd5b8151818111156102d6576102d6610238565b604051601f8201601f19908116603f011681019083821181831017156102fe576102fe610238565b81604052828152886020848701011115610316575f80fd5b61032783602083016020880161024c565b80955050505050509250929050565b5f825161034781846020870161024c565b9190910192915050565b60a38061035d5f395ff3fe6080604052600a600c565b005b60186014601a565b6050565b565b5f604b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc546001600160a01b031690565b905090565b365f80375f80365f845af43d5f803e8080156069573d5ff35b3d5ffdfea2646970667358221220ab780d060c0f7e272e56d67150e40c466de1258ac4c1d15a47d45b2c5ef09c4464736f6c63430008150033",
+}
+
+// OracleIndicatorProxyABI is the input ABI used to generate the binding from.
+// Deprecated: Use OracleIndicatorProxyMetaData.ABI instead.
+var OracleIndicatorProxyABI = OracleIndicatorProxyMetaData.ABI
+
+// OracleIndicatorProxyBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use OracleIndicatorProxyMetaData.Bin instead.
+var OracleIndicatorProxyBin = OracleIndicatorProxyMetaData.Bin
+
+// DeployOracleIndicatorProxy deploys a new Ethereum contract, binding an instance of OracleIndicatorProxy to it.
+func DeployOracleIndicatorProxy(auth *bind.TransactOpts, backend bind.ContractBackend, _implementation common.Address, _data []byte) (common.Address, *types.Transaction, *OracleIndicatorProxy, error) {
+	parsed, err := OracleIndicatorProxyMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(OracleIndicatorProxyBin), backend, _implementation, _data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &OracleIndicatorProxy{OracleIndicatorProxyCaller: OracleIndicatorProxyCaller{contract: contract}, OracleIndicatorProxyTransactor: OracleIndicatorProxyTransactor{contract: contract}, OracleIndicatorProxyFilterer: OracleIndicatorProxyFilterer{contract: contract}}, nil
+}
+
+// OracleIndicatorProxy is an auto generated Go binding around an Ethereum contract.
+type OracleIndicatorProxy struct {
+	OracleIndicatorProxyCaller     // Read-only binding to the contract
+	OracleIndicatorProxyTransactor // Write-only binding to the contract
+	OracleIndicatorProxyFilterer   // Log filterer for contract events
+}
+
+// OracleIndicatorProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OracleIndicatorProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OracleIndicatorProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OracleIndicatorProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OracleIndicatorProxySession struct {
+	Contract     *OracleIndicatorProxy // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OracleIndicatorProxyCallerSession struct {
+	Contract *OracleIndicatorProxyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// OracleIndicatorProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OracleIndicatorProxyTransactorSession struct {
+	Contract     *OracleIndicatorProxyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OracleIndicatorProxyRaw struct {
+	Contract *OracleIndicatorProxy // Generic contract binding to access the raw methods on
+}
+
+// OracleIndicatorProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OracleIndicatorProxyCallerRaw struct {
+	Contract *OracleIndicatorProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OracleIndicatorProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OracleIndicatorProxyTransactorRaw struct {
+	Contract *OracleIndicatorProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOracleIndicatorProxy creates a new instance of OracleIndicatorProxy, bound to a specific deployed contract.
+func NewOracleIndicatorProxy(address common.Address, backend bind.ContractBackend) (*OracleIndicatorProxy, error) {
+	contract, err := bindOracleIndicatorProxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorProxy{OracleIndicatorProxyCaller: OracleIndicatorProxyCaller{contract: contract}, OracleIndicatorProxyTransactor: OracleIndicatorProxyTransactor{contract: contract}, OracleIndicatorProxyFilterer: OracleIndicatorProxyFilterer{contract: contract}}, nil
+}
+
+// NewOracleIndicatorProxyCaller creates a new read-only instance of OracleIndicatorProxy, bound to a specific deployed contract.
+func NewOracleIndicatorProxyCaller(address common.Address, caller bind.ContractCaller) (*OracleIndicatorProxyCaller, error) {
+	contract, err := bindOracleIndicatorProxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorProxyCaller{contract: contract}, nil
+}
+
+// NewOracleIndicatorProxyTransactor creates a new write-only instance of OracleIndicatorProxy, bound to a specific deployed contract.
+func NewOracleIndicatorProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*OracleIndicatorProxyTransactor, error) {
+	contract, err := bindOracleIndicatorProxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorProxyTransactor{contract: contract}, nil
+}
+
+// NewOracleIndicatorProxyFilterer creates a new log filterer instance of OracleIndicatorProxy, bound to a specific deployed contract.
+func NewOracleIndicatorProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*OracleIndicatorProxyFilterer, error) {
+	contract, err := bindOracleIndicatorProxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorProxyFilterer{contract: contract}, nil
+}
+
+// bindOracleIndicatorProxy binds a generic wrapper to an already deployed contract.
+func bindOracleIndicatorProxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OracleIndicatorProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorProxy *OracleIndicatorProxyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorProxy.Contract.OracleIndicatorProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorProxy *OracleIndicatorProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.Contract.OracleIndicatorProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorProxy *OracleIndicatorProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.Contract.OracleIndicatorProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorProxy *OracleIndicatorProxyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorProxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorProxy *OracleIndicatorProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorProxy *OracleIndicatorProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_OracleIndicatorProxy *OracleIndicatorProxyTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_OracleIndicatorProxy *OracleIndicatorProxySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.Contract.Fallback(&_OracleIndicatorProxy.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_OracleIndicatorProxy *OracleIndicatorProxyTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _OracleIndicatorProxy.Contract.Fallback(&_OracleIndicatorProxy.TransactOpts, calldata)
+}
+
+// OracleIndicatorProxyUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the OracleIndicatorProxy contract.
+type OracleIndicatorProxyUpgradedIterator struct {
+	Event *OracleIndicatorProxyUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorProxyUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorProxyUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorProxyUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorProxyUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorProxyUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorProxyUpgraded represents a Upgraded event raised by the OracleIndicatorProxy contract.
+type OracleIndicatorProxyUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorProxy *OracleIndicatorProxyFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*OracleIndicatorProxyUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _OracleIndicatorProxy.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorProxyUpgradedIterator{contract: _OracleIndicatorProxy.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorProxy *OracleIndicatorProxyFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *OracleIndicatorProxyUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _OracleIndicatorProxy.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorProxyUpgraded)
+				if err := _OracleIndicatorProxy.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorProxy *OracleIndicatorProxyFilterer) ParseUpgraded(log types.Log) (*OracleIndicatorProxyUpgraded, error) {
+	event := new(OracleIndicatorProxyUpgraded)
+	if err := _OracleIndicatorProxy.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
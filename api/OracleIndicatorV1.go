@@ -0,0 +1,1621 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package api
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// OracleIndicatorV1DataFeed is an auto generated low-level Go binding around an user-defined struct.
+type OracleIndicatorV1DataFeed struct {
+	Value      *big.Int
+	Updatedat  *big.Int
+	Decimal    uint8
+	Confidence uint8
+}
+
+// OracleIndicatorV1MetaData contains all meta data concerning the OracleIndicatorV1 contract.
+var OracleIndicatorV1MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessControlBadConfirmation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}],\"name\":\"AccessControlUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"CheckpointUnderflow\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedInnerCall\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastDay\",\"type\":\"uint256\"}],\"name\":\"IndicatorOutOfOrder\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MathOverflowedMulDiv\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"READ_ONLY\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADE_INTERFACE_VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"checkpointCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimal\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getCumulativeInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"getDate\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicatorV1.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLast\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicatorV1.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getName\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"indicators\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"_defaultAdmin\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"}],\"name\":\"saveIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
+	Bin: "0x60a060405230608052348015610013575f80fd5b5061001c610021565b6100d3565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff16156100715760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146100d05780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b6080516118a86100f95f395f8181610ddb01528181610e040152610f4701526118a85ff3fe608060405260043610610131575f3560e01c80634f1ef286116100a85780638c4d068a1161006d5780638c4d068a1461035a57806391d148541461037957806392c871d214610398578063a217fddf14610406578063ad3cb1cc14610419578063d547741f14610449575f80fd5b80634f1ef286146102d957806352d1902d146102ec57806354fd4d501461030057806376809ce31461031b57806377c6e4401461033b575f80fd5b8063248a9ca3116100f9578063248a9ca3146101eb5780632b57298b1461020a5780632f2ff15d146102665780633488ecb31461028757806336568abe146102a65780634d622831146102c5575f80fd5b806301ffc9a7146101355780630e5fa7f11461016957806315eecf211461019657806317d7de7c146101b65780631f618cd2146101d7575b5f80fd5b348015610140575f80fd5b5061015461014f36600461137c565b610468565b60405190151581526020015b60405180910390f35b348015610174575f80fd5b506101886101833660046113a3565b61049e565b604051908152602001610160565b3480156101a1575f80fd5b506101885f8051602061185383398151915281565b3480156101c1575f80fd5b506101ca61061e565b60405161016091906113e5565b3480156101e2575f80fd5b50600654610188565b3480156101f6575f80fd5b50610188610205366004611417565b6106ae565b348015610215575f80fd5b50610229610224366004611417565b6106ce565b60405161016091905f608082019050825182526020830151602083015260ff604084015116604083015260ff606084015116606083015292915050565b348015610271575f80fd5b50610285610280366004611449565b610770565b005b348015610292575f80fd5b506101886102a13660046113a3565b610792565b3480156102b1575f80fd5b506102856102c0366004611449565b61083f565b3480156102d0575f80fd5b50610229610877565b6102856102e73660046114fa565b6108e9565b3480156102f7575f80fd5b50610188610908565b34801561030b575f80fd5b5060405160018152602001610160565b348015610326575f80fd5b505f5460405160ff9091168152602001610160565b348015610346575f80fd5b50610285610355366004611568565b610923565b348015610365575f80fd5b506102856103743660046115a4565b6109ec565b348015610384575f80fd5b50610154610393366004611449565b610b29565b3480156103a3575f80fd5b506103dd6103b2366004611417565b600260208190525f918252604090912080546001820154919092015460ff8082169161010090041684565b60408051948552602085019390935260ff91821692840192909252166060820152608001610160565b348015610411575f80fd5b506101885f81565b348015610424575f80fd5b506101ca604051806040016040528060058152602001640352e302e360dc1b81525081565b348015610454575f80fd5b50610285610463366004611449565b610b5f565b5f6001600160e01b03198216637965db0b60e01b148061049857506301ffc9a760e01b6001600160e01b03198316145b92915050565b5f5f805160206118538339815191526104b681610b7b565b5f6104c46201518086611625565b6104ce908661164c565b90505f6104de6201518086611625565b6104e8908661164c565b90505f6104f482610b88565b90505f83156105155761051061050b60018661164c565b610b88565b610517565b5f5b9050808211610530576305f5e100955050505050610617565b5f811561056957600661054460018461164c565b815481106105545761055461165f565b905f5260205f2090600202016001015461057a565b6ec097ce7bc90715b34b9f10000000005b9050805f036105d457600661059060018461164c565b815481106105a0576105a061165f565b905f5260205f2090600202015f015460405163a0eb9ab760e01b81526004016105cb91815260200190565b60405180910390fd5b61060f60066105e460018661164c565b815481106105f4576105f461165f565b905f5260205f209060020201600101546305f5e10083610bf6565b965050505050505b5092915050565b60606001805461062d90611673565b80601f016020809104026020016040519081016040528092919081815260200182805461065990611673565b80156106a45780601f1061067b576101008083540402835291602001916106a4565b820191905f5260205f20905b81548152906001019060200180831161068757829003601f168201915b5050505050905090565b5f9081525f80516020611833833981519152602052604090206001015490565b604080516080810182525f8082526020820181905291810182905260608101919091525f8051602061185383398151915261070881610b7b565b5f6107166201518085611625565b610720908561164c565b5f908152600260208181526040928390208351608081018552815481526001820154928101929092529091015460ff80821693830193909352610100900490911660608201529250505b50919050565b610779826106ae565b61078281610b7b565b61078c8383610cb6565b50505050565b5f5f805160206118538339815191526107aa81610b7b565b5f6107b86201518086611625565b6107c2908661164c565b90505f6107d26201518086611625565b6107dc908661164c565b90506305f5e100825b828111610834575f8181526002602052604081205412610820575f8181526002602052604090205461081d9083906305f5e100610bf6565b91505b61082d62015180826116a5565b90506107e5565b509695505050505050565b6001600160a01b03811633146108685760405163334bd91960e11b815260040160405180910390fd5b6108728282610d57565b505050565b604080516080810182525f8082526020820181905291810182905260608101919091525f805160206118538339815191526108b181610b7b565b5050604080516080810182526003548152600454602082015260055460ff808216938301939093526101009004909116606082015290565b6108f1610dd0565b6108fa82610e76565b6109048282610e80565b5050565b5f610911610f3c565b505f8051602061181383398151915290565b5f61092d81610b7b565b5f61093b6201518087611625565b610945908761164c565b6040805160808101825287815260208082018890525f805460ff908116848601819052898216606090950185905260038c905560048b81556005805461ffff19908116909317610100978802178155888552600295869052969093208c815592546001840155855492909301805492821660ff198416811782559554929093169094179083900490931690910291909117905590506109e48186610f85565b505050505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff16159067ffffffffffffffff165f81158015610a315750825b90505f8267ffffffffffffffff166001148015610a4d5750303b155b905081158015610a5b575080155b15610a795760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff191660011785558315610aa357845460ff60401b1916600160401b1785555b610aab611124565b610ab3611124565b5f805460ff191660ff89161790556001610acd89826116fd565b50610ad85f87610cb6565b508315610b1f57845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050505050565b5f9182525f80516020611833833981519152602090815260408084206001600160a01b0393909316845291905290205460ff1690565b610b68826106ae565b610b7181610b7b565b61078c8383610d57565b610b85813361112c565b50565b6006545f9081905b80821015610617575f6002610ba583856116a5565b610baf91906117b9565b90508460068281548110610bc557610bc561165f565b905f5260205f2090600202015f01541115610be257809150610bf0565b610bed8160016116a5565b92505b50610b90565b5f838302815f1985870982811083820303915050805f03610c2a57838281610c2057610c20611611565b0492505050610caf565b808411610c4a5760405163227bc15360e01b815260040160405180910390fd5b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010186841190950394909402919094039290920491909117919091029150505b9392505050565b5f5f80516020611833833981519152610ccf8484610b29565b610d4e575f848152602082815260408083206001600160a01b03871684529091529020805460ff19166001179055610d043390565b6001600160a01b0316836001600160a01b0316857f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a46001915050610498565b5f915050610498565b5f5f80516020611833833981519152610d708484610b29565b15610d4e575f848152602082815260408083206001600160a01b0387168085529252808320805460ff1916905551339287917ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b9190a46001915050610498565b306001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161480610e5657507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316610e4a5f80516020611813833981519152546001600160a01b031690565b6001600160a01b031614155b15610e745760405163703e46dd60e11b815260040160405180910390fd5b565b5f61090481610b7b565b816001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa925050508015610eda575060408051601f3d908101601f19168201909252610ed7918101906117cc565b60015b610f0257604051634c9c8ce360e01b81526001600160a01b03831660048201526024016105cb565b5f805160206118138339815191528114610f3257604051632a87526960e21b8152600481018290526024016105cb565b6108728383611165565b306001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614610e745760405163703e46dd60e11b815260040160405180910390fd5b6006546ec097ce7bc90715b34b9f1000000000811561108c575f6006610fac60018561164c565b81548110610fbc57610fbc61165f565b905f5260205f2090600202019050805f0154851015610ffb5780546040516320437e4f60e01b81526105cb918791600401918252602082015260400190565b805485036110825760018311611020576ec097ce7bc90715b34b9f100000000061104e565b600661102d60028561164c565b8154811061103d5761103d61165f565b905f5260205f209060020201600101545b91506006805480611061576110616117e3565b5f8281526020812060025f199093019283020181815560010155905561108a565b806001015491505b505b805f8413156110a7576110a482856305f5e100610bf6565b90505b6040805180820190915294855260208501908152600680546001810182555f9190915294517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3f600290960295860155517ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d4090940193909355505050565b610e746111ba565b6111368282610b29565b6109045760405163e2517d3f60e01b81526001600160a01b0382166004820152602481018390526044016105cb565b61116e82611203565b6040516001600160a01b038316907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b905f90a28051156111b2576108728282611266565b6109046112d8565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff16610e7457604051631afcd79f60e31b815260040160405180910390fd5b806001600160a01b03163b5f0361123857604051634c9c8ce360e01b81526001600160a01b03821660048201526024016105cb565b5f8051602061181383398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b60605f80846001600160a01b03168460405161128291906117f7565b5f60405180830381855af49150503d805f81146112ba576040519150601f19603f3d011682016040523d82523d5f602084013e6112bf565b606091505b50915091506112cf8583836112f7565b95945050505050565b3415610e745760405163b398979f60e01b815260040160405180910390fd5b60608261130c5761130782611353565b610caf565b815115801561132357506001600160a01b0384163b155b1561134c57604051639996b31560e01b81526001600160a01b03851660048201526024016105cb565b5080610caf565b8051156113635780518082602001fd5b604051630a12f52160e11b815260040160405180910390fd5b5f6020828403121561138c575f80fd5b81356001600160e01b031981168114610caf575f80fd5b5f80604083850312156113b4575f80fd5b50508035926020909101359150565b5f5b838110156113dd5781810151838201526020016113c5565b50505f910152565b602081525f82518060208401526114038160408501602087016113c3565b601f01601f19169190910160400192915050565b5f60208284031215611427575f80fd5b5035919050565b80356001600160a01b0381168114611444575f80fd5b919050565b5f806040838503121561145a575f80fd5b8235915061146a6020840161142e565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f67ffffffffffffffff808411156114a1576114a1611473565b604051601f8501601f19908116603f011681019082821181831017156114c9576114c9611473565b816040528093508581528686860111156114e1575f80fd5b858560208301375f602087830101525050509392505050565b5f806040838503121561150b575f80fd5b6115148361142e565b9150602083013567ffffffffffffffff81111561152f575f80fd5b8301601f8101851361153f575f80fd5b61154e85823560208401611487565b9150509250929050565b803560ff81168114611444575f80fd5b5f805f806080858703121561157b575f80fd5b84359350602085013592506040850135915061159960608601611558565b905092959194509250565b5f805f606084860312156115b6575f80fd5b833567ffffffffffffffff8111156115cc575f80fd5b8401601f810186136115dc575f80fd5b6115eb86823560208401611487565b9350506115fa60208501611558565b91506116086040850161142e565b90509250925092565b634e487b7160e01b5f52601260045260245ffd5b5f8261163357611633611611565b500690565b634e487b7160e01b5f52601160045260245ffd5b8181038181111561049857610498611638565b634e487b7160e01b5f52603260045260245ffd5b600181811c9082168061168757607f821691505b60208210810361076a57634e487b7160e01b5f52602260045260245ffd5b8082018082111561049857610498611638565b601f821115610872575f81815260208120601f850160051c810160208610156116de5750805b601f850160051c820191505b818110156109e4578281556001016116ea565b815167ffffffffffffffff81111561171757611717611473565b61172b816117258454611673565b846116b8565b602080601f83116001811461175e575f84156117475750858301515b5f19600386901b1c1916600185901b1785556109e4565b5f85815260208120601f198616915b8281101561178c5788860151825594840194600190910190840161176d565b50858210156117a957878501515f19600388901b60f8161c191681555b5050505050600190811b01905550565b5f826117c7576117c7611611565b500490565b5f602082840312156117dc575f80fd5b5051919050565b634e487b7160e01b5f52603160045260245ffd5b5f82516118088184602087016113c3565b919091019291505056fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc02dd7bc7dec4dceedda775e58dd541e08a116c6c53815c0bd028192f7b626800b46ce43d76047f77f110931243fb48b444c01f8ce7d297bf5cdc21cb7634e000a26469706673582212204b5fd37c454c2c5b9dfa7d1af20f6a58cfb9ae6cee3da3b71b33cbfff85857cf64736f6c63430008150033",
+}
+
+// OracleIndicatorV1ABI is the input ABI used to generate the binding from.
+// Deprecated: Use OracleIndicatorV1MetaData.ABI instead.
+var OracleIndicatorV1ABI = OracleIndicatorV1MetaData.ABI
+
+// OracleIndicatorV1Bin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use OracleIndicatorV1MetaData.Bin instead.
+var OracleIndicatorV1Bin = OracleIndicatorV1MetaData.Bin
+
+// DeployOracleIndicatorV1 deploys a new Ethereum contract, binding an instance of OracleIndicatorV1 to it.
+func DeployOracleIndicatorV1(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *OracleIndicatorV1, error) {
+	parsed, err := OracleIndicatorV1MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(OracleIndicatorV1Bin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &OracleIndicatorV1{OracleIndicatorV1Caller: OracleIndicatorV1Caller{contract: contract}, OracleIndicatorV1Transactor: OracleIndicatorV1Transactor{contract: contract}, OracleIndicatorV1Filterer: OracleIndicatorV1Filterer{contract: contract}}, nil
+}
+
+// OracleIndicatorV1 is an auto generated Go binding around an Ethereum contract.
+type OracleIndicatorV1 struct {
+	OracleIndicatorV1Caller     // Read-only binding to the contract
+	OracleIndicatorV1Transactor // Write-only binding to the contract
+	OracleIndicatorV1Filterer   // Log filterer for contract events
+}
+
+// OracleIndicatorV1Caller is an auto generated read-only Go binding around an Ethereum contract.
+type OracleIndicatorV1Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorV1Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type OracleIndicatorV1Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorV1Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OracleIndicatorV1Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorV1Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OracleIndicatorV1Session struct {
+	Contract     *OracleIndicatorV1 // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorV1CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OracleIndicatorV1CallerSession struct {
+	Contract *OracleIndicatorV1Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// OracleIndicatorV1TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OracleIndicatorV1TransactorSession struct {
+	Contract     *OracleIndicatorV1Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorV1Raw is an auto generated low-level Go binding around an Ethereum contract.
+type OracleIndicatorV1Raw struct {
+	Contract *OracleIndicatorV1 // Generic contract binding to access the raw methods on
+}
+
+// OracleIndicatorV1CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OracleIndicatorV1CallerRaw struct {
+	Contract *OracleIndicatorV1Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// OracleIndicatorV1TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OracleIndicatorV1TransactorRaw struct {
+	Contract *OracleIndicatorV1Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOracleIndicatorV1 creates a new instance of OracleIndicatorV1, bound to a specific deployed contract.
+func NewOracleIndicatorV1(address common.Address, backend bind.ContractBackend) (*OracleIndicatorV1, error) {
+	contract, err := bindOracleIndicatorV1(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1{OracleIndicatorV1Caller: OracleIndicatorV1Caller{contract: contract}, OracleIndicatorV1Transactor: OracleIndicatorV1Transactor{contract: contract}, OracleIndicatorV1Filterer: OracleIndicatorV1Filterer{contract: contract}}, nil
+}
+
+// NewOracleIndicatorV1Caller creates a new read-only instance of OracleIndicatorV1, bound to a specific deployed contract.
+func NewOracleIndicatorV1Caller(address common.Address, caller bind.ContractCaller) (*OracleIndicatorV1Caller, error) {
+	contract, err := bindOracleIndicatorV1(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1Caller{contract: contract}, nil
+}
+
+// NewOracleIndicatorV1Transactor creates a new write-only instance of OracleIndicatorV1, bound to a specific deployed contract.
+func NewOracleIndicatorV1Transactor(address common.Address, transactor bind.ContractTransactor) (*OracleIndicatorV1Transactor, error) {
+	contract, err := bindOracleIndicatorV1(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1Transactor{contract: contract}, nil
+}
+
+// NewOracleIndicatorV1Filterer creates a new log filterer instance of OracleIndicatorV1, bound to a specific deployed contract.
+func NewOracleIndicatorV1Filterer(address common.Address, filterer bind.ContractFilterer) (*OracleIndicatorV1Filterer, error) {
+	contract, err := bindOracleIndicatorV1(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1Filterer{contract: contract}, nil
+}
+
+// bindOracleIndicatorV1 binds a generic wrapper to an already deployed contract.
+func bindOracleIndicatorV1(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OracleIndicatorV1MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorV1 *OracleIndicatorV1Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorV1.Contract.OracleIndicatorV1Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorV1 *OracleIndicatorV1Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.OracleIndicatorV1Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorV1 *OracleIndicatorV1Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.OracleIndicatorV1Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorV1.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.contract.Transact(opts, method, params...)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) DEFAULTADMINROLE() ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.DEFAULTADMINROLE(&_OracleIndicatorV1.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.DEFAULTADMINROLE(&_OracleIndicatorV1.CallOpts)
+}
+
+// READONLY is a free data retrieval call binding the contract method 0x15eecf21.
+//
+// Solidity: function READ_ONLY() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) READONLY(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "READ_ONLY")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// READONLY is a free data retrieval call binding the contract method 0x15eecf21.
+//
+// Solidity: function READ_ONLY() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) READONLY() ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.READONLY(&_OracleIndicatorV1.CallOpts)
+}
+
+// READONLY is a free data retrieval call binding the contract method 0x15eecf21.
+//
+// Solidity: function READ_ONLY() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) READONLY() ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.READONLY(&_OracleIndicatorV1.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) UPGRADEINTERFACEVERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "UPGRADE_INTERFACE_VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) UPGRADEINTERFACEVERSION() (string, error) {
+	return _OracleIndicatorV1.Contract.UPGRADEINTERFACEVERSION(&_OracleIndicatorV1.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _OracleIndicatorV1.Contract.UPGRADEINTERFACEVERSION(&_OracleIndicatorV1.CallOpts)
+}
+
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
+//
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) CheckpointCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "checkpointCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
+//
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) CheckpointCount() (*big.Int, error) {
+	return _OracleIndicatorV1.Contract.CheckpointCount(&_OracleIndicatorV1.CallOpts)
+}
+
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
+//
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) CheckpointCount() (*big.Int, error) {
+	return _OracleIndicatorV1.Contract.CheckpointCount(&_OracleIndicatorV1.CallOpts)
+}
+
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+//
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) Decimal(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "decimal")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+//
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) Decimal() (uint8, error) {
+	return _OracleIndicatorV1.Contract.Decimal(&_OracleIndicatorV1.CallOpts)
+}
+
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+//
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) Decimal() (uint8, error) {
+	return _OracleIndicatorV1.Contract.Decimal(&_OracleIndicatorV1.CallOpts)
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) GetCumulativeInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "getCumulativeInterval", _start, _end)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GetCumulativeInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV1.Contract.GetCumulativeInterval(&_OracleIndicatorV1.CallOpts, _start, _end)
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) GetCumulativeInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV1.Contract.GetCumulativeInterval(&_OracleIndicatorV1.CallOpts, _start, _end)
+}
+
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+//
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) GetDate(opts *bind.CallOpts, _timestamp *big.Int) (OracleIndicatorV1DataFeed, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "getDate", _timestamp)
+
+	if err != nil {
+		return *new(OracleIndicatorV1DataFeed), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(OracleIndicatorV1DataFeed)).(*OracleIndicatorV1DataFeed)
+
+	return out0, err
+
+}
+
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+//
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GetDate(_timestamp *big.Int) (OracleIndicatorV1DataFeed, error) {
+	return _OracleIndicatorV1.Contract.GetDate(&_OracleIndicatorV1.CallOpts, _timestamp)
+}
+
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+//
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) GetDate(_timestamp *big.Int) (OracleIndicatorV1DataFeed, error) {
+	return _OracleIndicatorV1.Contract.GetDate(&_OracleIndicatorV1.CallOpts, _timestamp)
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) GetInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "getInterval", _start, _end)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV1.Contract.GetInterval(&_OracleIndicatorV1.CallOpts, _start, _end)
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV1.Contract.GetInterval(&_OracleIndicatorV1.CallOpts, _start, _end)
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) GetLast(opts *bind.CallOpts) (OracleIndicatorV1DataFeed, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "getLast")
+
+	if err != nil {
+		return *new(OracleIndicatorV1DataFeed), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(OracleIndicatorV1DataFeed)).(*OracleIndicatorV1DataFeed)
+
+	return out0, err
+
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GetLast() (OracleIndicatorV1DataFeed, error) {
+	return _OracleIndicatorV1.Contract.GetLast(&_OracleIndicatorV1.CallOpts)
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) GetLast() (OracleIndicatorV1DataFeed, error) {
+	return _OracleIndicatorV1.Contract.GetLast(&_OracleIndicatorV1.CallOpts)
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) GetName(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "getName")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GetName() (string, error) {
+	return _OracleIndicatorV1.Contract.GetName(&_OracleIndicatorV1.CallOpts)
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) GetName() (string, error) {
+	return _OracleIndicatorV1.Contract.GetName(&_OracleIndicatorV1.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.GetRoleAdmin(&_OracleIndicatorV1.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.GetRoleAdmin(&_OracleIndicatorV1.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _OracleIndicatorV1.Contract.HasRole(&_OracleIndicatorV1.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _OracleIndicatorV1.Contract.HasRole(&_OracleIndicatorV1.CallOpts, role, account)
+}
+
+// Indicators is a free data retrieval call binding the contract method 0x92c871d2.
+//
+// Solidity: function indicators(uint256 ) view returns(int256 value, uint256 updatedat, uint8 decimal, uint8 confidence)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) Indicators(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Value      *big.Int
+	Updatedat  *big.Int
+	Decimal    uint8
+	Confidence uint8
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "indicators", arg0)
+
+	outstruct := new(struct {
+		Value      *big.Int
+		Updatedat  *big.Int
+		Decimal    uint8
+		Confidence uint8
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Updatedat = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Decimal = *abi.ConvertType(out[2], new(uint8)).(*uint8)
+	outstruct.Confidence = *abi.ConvertType(out[3], new(uint8)).(*uint8)
+
+	return *outstruct, err
+
+}
+
+// Indicators is a free data retrieval call binding the contract method 0x92c871d2.
+//
+// Solidity: function indicators(uint256 ) view returns(int256 value, uint256 updatedat, uint8 decimal, uint8 confidence)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) Indicators(arg0 *big.Int) (struct {
+	Value      *big.Int
+	Updatedat  *big.Int
+	Decimal    uint8
+	Confidence uint8
+}, error) {
+	return _OracleIndicatorV1.Contract.Indicators(&_OracleIndicatorV1.CallOpts, arg0)
+}
+
+// Indicators is a free data retrieval call binding the contract method 0x92c871d2.
+//
+// Solidity: function indicators(uint256 ) view returns(int256 value, uint256 updatedat, uint8 decimal, uint8 confidence)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) Indicators(arg0 *big.Int) (struct {
+	Value      *big.Int
+	Updatedat  *big.Int
+	Decimal    uint8
+	Confidence uint8
+}, error) {
+	return _OracleIndicatorV1.Contract.Indicators(&_OracleIndicatorV1.CallOpts, arg0)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) ProxiableUUID() ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.ProxiableUUID(&_OracleIndicatorV1.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) ProxiableUUID() ([32]byte, error) {
+	return _OracleIndicatorV1.Contract.ProxiableUUID(&_OracleIndicatorV1.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _OracleIndicatorV1.Contract.SupportsInterface(&_OracleIndicatorV1.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _OracleIndicatorV1.Contract.SupportsInterface(&_OracleIndicatorV1.CallOpts, interfaceId)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint64)
+func (_OracleIndicatorV1 *OracleIndicatorV1Caller) Version(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _OracleIndicatorV1.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint64)
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) Version() (uint64, error) {
+	return _OracleIndicatorV1.Contract.Version(&_OracleIndicatorV1.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint64)
+func (_OracleIndicatorV1 *OracleIndicatorV1CallerSession) Version() (uint64, error) {
+	return _OracleIndicatorV1.Contract.Version(&_OracleIndicatorV1.CallOpts)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Transactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.GrantRole(&_OracleIndicatorV1.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.GrantRole(&_OracleIndicatorV1.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8c4d068a.
+//
+// Solidity: function initialize(string _name, uint8 _decimals, address _defaultAdmin) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Transactor) Initialize(opts *bind.TransactOpts, _name string, _decimals uint8, _defaultAdmin common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.contract.Transact(opts, "initialize", _name, _decimals, _defaultAdmin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8c4d068a.
+//
+// Solidity: function initialize(string _name, uint8 _decimals, address _defaultAdmin) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) Initialize(_name string, _decimals uint8, _defaultAdmin common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.Initialize(&_OracleIndicatorV1.TransactOpts, _name, _decimals, _defaultAdmin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8c4d068a.
+//
+// Solidity: function initialize(string _name, uint8 _decimals, address _defaultAdmin) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorSession) Initialize(_name string, _decimals uint8, _defaultAdmin common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.Initialize(&_OracleIndicatorV1.TransactOpts, _name, _decimals, _defaultAdmin)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Transactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.RenounceRole(&_OracleIndicatorV1.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.RenounceRole(&_OracleIndicatorV1.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Transactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.RevokeRole(&_OracleIndicatorV1.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.RevokeRole(&_OracleIndicatorV1.TransactOpts, role, account)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Transactor) SaveIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV1.contract.Transact(opts, "saveIndicator", _timestamp, _value, _updatedat, _confidence)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.SaveIndicator(&_OracleIndicatorV1.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorSession) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.SaveIndicator(&_OracleIndicatorV1.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Transactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV1.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1Session) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.UpgradeToAndCall(&_OracleIndicatorV1.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_OracleIndicatorV1 *OracleIndicatorV1TransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV1.Contract.UpgradeToAndCall(&_OracleIndicatorV1.TransactOpts, newImplementation, data)
+}
+
+// OracleIndicatorV1InitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1InitializedIterator struct {
+	Event *OracleIndicatorV1Initialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV1InitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV1Initialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV1Initialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV1InitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV1InitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV1Initialized represents a Initialized event raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1Initialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) FilterInitialized(opts *bind.FilterOpts) (*OracleIndicatorV1InitializedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV1.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1InitializedIterator{contract: _OracleIndicatorV1.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV1Initialized) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV1.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV1Initialized)
+				if err := _OracleIndicatorV1.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) ParseInitialized(log types.Log) (*OracleIndicatorV1Initialized, error) {
+	event := new(OracleIndicatorV1Initialized)
+	if err := _OracleIndicatorV1.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV1RoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1RoleAdminChangedIterator struct {
+	Event *OracleIndicatorV1RoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV1RoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV1RoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV1RoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV1RoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV1RoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV1RoleAdminChanged represents a RoleAdminChanged event raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1RoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*OracleIndicatorV1RoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1RoleAdminChangedIterator{contract: _OracleIndicatorV1.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV1RoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV1RoleAdminChanged)
+				if err := _OracleIndicatorV1.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) ParseRoleAdminChanged(log types.Log) (*OracleIndicatorV1RoleAdminChanged, error) {
+	event := new(OracleIndicatorV1RoleAdminChanged)
+	if err := _OracleIndicatorV1.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV1RoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1RoleGrantedIterator struct {
+	Event *OracleIndicatorV1RoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV1RoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV1RoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV1RoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV1RoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV1RoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV1RoleGranted represents a RoleGranted event raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1RoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*OracleIndicatorV1RoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1RoleGrantedIterator{contract: _OracleIndicatorV1.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV1RoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV1RoleGranted)
+				if err := _OracleIndicatorV1.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) ParseRoleGranted(log types.Log) (*OracleIndicatorV1RoleGranted, error) {
+	event := new(OracleIndicatorV1RoleGranted)
+	if err := _OracleIndicatorV1.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV1RoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1RoleRevokedIterator struct {
+	Event *OracleIndicatorV1RoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV1RoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV1RoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV1RoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV1RoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV1RoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV1RoleRevoked represents a RoleRevoked event raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1RoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*OracleIndicatorV1RoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1RoleRevokedIterator{contract: _OracleIndicatorV1.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV1RoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV1RoleRevoked)
+				if err := _OracleIndicatorV1.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) ParseRoleRevoked(log types.Log) (*OracleIndicatorV1RoleRevoked, error) {
+	event := new(OracleIndicatorV1RoleRevoked)
+	if err := _OracleIndicatorV1.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV1UpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1UpgradedIterator struct {
+	Event *OracleIndicatorV1Upgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV1UpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV1Upgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV1Upgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV1UpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV1UpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV1Upgraded represents a Upgraded event raised by the OracleIndicatorV1 contract.
+type OracleIndicatorV1Upgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*OracleIndicatorV1UpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV1UpgradedIterator{contract: _OracleIndicatorV1.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV1Upgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV1.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV1Upgraded)
+				if err := _OracleIndicatorV1.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorV1 *OracleIndicatorV1Filterer) ParseUpgraded(log types.Log) (*OracleIndicatorV1Upgraded, error) {
+	event := new(OracleIndicatorV1Upgraded)
+	if err := _OracleIndicatorV1.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
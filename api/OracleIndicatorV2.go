@@ -0,0 +1,4381 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package api
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// OracleIndicatorV2DataFeed is an auto generated low-level Go binding around an user-defined struct.
+type OracleIndicatorV2DataFeed struct {
+	Value      *big.Int
+	Updatedat  *big.Int
+	Decimal    uint8
+	Confidence uint8
+}
+
+// OracleIndicatorV2MetaData contains all meta data concerning the OracleIndicatorV2 contract.
+var OracleIndicatorV2MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessControlBadConfirmation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}],\"name\":\"AccessControlUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"reporter\",\"type\":\"address\"}],\"name\":\"AlreadySubmitted\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ConsensusDisabled\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ConsensusEnabled\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"DayAlreadyFinalized\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"last\",\"type\":\"int256\"},{\"internalType\":\"uint16\",\"name\":\"maxDeviationBps\",\"type\":\"uint16\"}],\"name\":\"DeviationTooLarge\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EnforcedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ExpectedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedInnerCall\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"IndicatorNotFound\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"IndicatorRetracted\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"quorum\",\"type\":\"uint8\"},{\"internalType\":\"uint16\",\"name\":\"toleranceBps\",\"type\":\"uint16\"}],\"name\":\"InvalidConsensus\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"minValue\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"maxValue\",\"type\":\"int256\"}],\"name\":\"InvalidLimits\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"accounts\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiries\",\"type\":\"uint256\"}],\"name\":\"LengthMismatch\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MathOverflowedMulDiv\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"remaining\",\"type\":\"uint256\"}],\"name\":\"MigrationPending\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expiresAt\",\"type\":\"uint256\"}],\"name\":\"ReadAccessExpired\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"digest\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"ReportAlreadySubmitted\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"ReportExpired\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"}],\"name\":\"StaleReport\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"UnauthorizedReporter\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"minValue\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"maxValue\",\"type\":\"int256\"}],\"name\":\"ValueOutOfBounds\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"enumOracleIndicatorV2.AccessMode\",\"name\":\"mode\",\"type\":\"uint8\"}],\"name\":\"AccessModeChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"migrated\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"remaining\",\"type\":\"uint256\"}],\"name\":\"CheckpointsMigrated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"quorum\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"toleranceBps\",\"type\":\"uint16\"}],\"name\":\"ConsensusChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"EIP712DomainChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"IndicatorInvalidated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"}],\"name\":\"IndicatorRestored\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"minValue\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"maxValue\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"maxDeviationBps\",\"type\":\"uint16\"}],\"name\":\"LimitsChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"day\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"LimitsOverridden\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expiresAt\",\"type\":\"uint256\"}],\"name\":\"ReadAccessGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"ReadAccessRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GUARDIAN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_QUORUM\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"PUBLISHER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"READ_ONLY\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REPORTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REPORT_TYPEHASH\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADE_INTERFACE_VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"accessMode\",\"outputs\":[{\"internalType\":\"enumOracleIndicatorV2.AccessMode\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"canRead\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"checkpointCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"consensusRound\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"submissions\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"finalized\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimal\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deviationBase\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"eip712Domain\",\"outputs\":[{\"internalType\":\"bytes1\",\"name\":\"fields\",\"type\":\"bytes1\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"chainId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"verifyingContract\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"internalType\":\"uint256[]\",\"name\":\"extensions\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getCumulativeInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"getDate\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicatorV2.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"getDay\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicatorV2.DataFeed\",\"name\":\"feed\",\"type\":\"tuple\"},{\"internalType\":\"bool\",\"name\":\"isRetracted\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"}],\"name\":\"getInterval\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLast\",\"outputs\":[{\"components\":[{\"internalType\":\"int256\",\"name\":\"value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"decimal\",\"type\":\"uint8\"},{\"internalType\":\"uint8\",\"name\":\"confidence\",\"type\":\"uint8\"}],\"internalType\":\"structOracleIndicatorV2.DataFeed\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getName\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_expiries\",\"type\":\"uint256[]\"}],\"name\":\"grantReadAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_reporter\",\"type\":\"address\"}],\"name\":\"hasSubmitted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"_defaultAdmin\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initializeV2\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"invalidate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastDay\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxDeviationBps\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxValue\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_count\",\"type\":\"uint256\"}],\"name\":\"migrateCheckpoints\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"minValue\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"}],\"name\":\"overrideIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pendingCheckpoints\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"quorum\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"readExpiry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"}],\"name\":\"reportDigest\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"restore\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"retracted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"retractedCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_accounts\",\"type\":\"address[]\"}],\"name\":\"revokeReadAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"}],\"name\":\"saveIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumOracleIndicatorV2.AccessMode\",\"name\":\"_mode\",\"type\":\"uint8\"}],\"name\":\"setAccessMode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"_quorum\",\"type\":\"uint8\"},{\"internalType\":\"uint16\",\"name\":\"_toleranceBps\",\"type\":\"uint16\"}],\"name\":\"setConsensus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int256\",\"name\":\"_min\",\"type\":\"int256\"},{\"internalType\":\"int256\",\"name\":\"_max\",\"type\":\"int256\"},{\"internalType\":\"uint16\",\"name\":\"_maxDeviationBps\",\"type\":\"uint16\"}],\"name\":\"setLimits\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"_confidence\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"_deadline\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_signature\",\"type\":\"bytes\"}],\"name\":\"submitSignedIndicator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"int256\",\"name\":\"_value\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"_updatedat\",\"type\":\"uint256\"}],\"name\":\"submitValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"submittedReports\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"toleranceBps\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
+	Bin: "0x60a06040523060805234801562000014575f80fd5b506200001f62000025565b620000d9565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff1615620000765760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b0390811614620000d65780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b6080516140f5620001005f395f8181612481015281816124aa01526125eb01526140f55ff3fe608060405260043610610387575f3560e01c806365c72840116101d35780639bea62ad116100fd578063d1607cdb1161009d578063d8f4b6fd1161006d578063d8f4b6fd14610aa1578063f2ac3f0814610ac1578063f69e0f1c14610ae0578063fac6297214610aff575f80fd5b8063d1607cdb14610a3b578063d2cbc86714610a4f578063d547741f14610a63578063d5c2d6fd14610a82575f80fd5b8063a57d3806116100d8578063a57d3806146109ae578063ad3cb1cc146109cd578063bf48027c146109fd578063cd64f6fb14610a1c575f80fd5b80639bea62ad1461094e5780639fa2c7761461096d578063a217fddf1461099b575f80fd5b80638456cb591161017357806391d148541161014357806391d14854146108e657806394a5c2e414610905578063963e63c71461091a5780639b824d0c1461092f575f80fd5b80638456cb591461086d57806384b0196e146108815780638c4d068a146108a85780638fd92eab146108c7575f80fd5b806376aad3fb116101ae57806376aad3fb146107ea57806377c6e440146108095780637b5c6e28146108285780637e31d2cc1461084e575f80fd5b806365c72840146107925780636b0c932d146107bf57806376809ce3146107d4575f80fd5b806339d80c27116102b45780634d6228311161025457806355cc207b1161022457806355cc207b146107115780635780f8411461073c5780635c975abb1461075b5780635cd8a76b1461077e575f80fd5b80634d622831146106bb5780634f1ef286146106cf57806352d1902d146106e257806354fd4d50146106f6575f80fd5b80633f4ba83a1161028f5780633f4ba83a146106225780633f60d7991461063657806342087d4f146106695780634a882fc314610688575f80fd5b806339d80c271461059d5780633ca956d8146105bc5780633ee7a701146105f5575f80fd5b80631f618cd21161032a5780632c0af1ce116102fa5780632c0af1ce1461050c5780632f2ff15d146105405780633488ecb31461055f57806336568abe1461057e575f80fd5b80631f618cd21461048d578063248a9ca3146104a157806324ea54f4146104c05780632b57298b146104e0575f80fd5b806315eecf211161036557806315eecf21146104005780631703a0181461042057806317d7de7c1461044b5780631ea1afdb1461046c575f80fd5b806301b351f31461038b57806301ffc9a7146103b25780630e5fa7f1146103e1575b5f80fd5b348015610396575f80fd5b5061039f610b13565b6040519081526020015b60405180910390f35b3480156103bd575f80fd5b506103d16103cc36600461369a565b610b29565b60405190151581526020016103a9565b3480156103ec575f80fd5b5061039f6103fb3660046136c1565b610b5f565b34801561040b575f80fd5b5061039f5f8051602061408083398151915281565b34801561042b575f80fd5b50600c546104399060ff1681565b60405160ff90911681526020016103a9565b348015610456575f80fd5b5061045f610d94565b6040516103a9919061372e565b348015610477575f80fd5b5061048b610486366004613740565b610e24565b005b348015610498575f80fd5b50600a5461039f565b3480156104ac575f80fd5b5061039f6104bb366004613740565b610f2c565b3480156104cb575f80fd5b5061039f5f805160206140a083398151915281565b3480156104eb575f80fd5b506104ff6104fa366004613740565b610f4c565b6040516103a99190613757565b348015610517575f80fd5b5061052b610526366004613740565b610ff1565b604080519283529015156020830152016103a9565b34801561054b575f80fd5b5061048b61055a3660046137a2565b611034565b34801561056a575f80fd5b5061039f6105793660046136c1565b611056565b348015610589575f80fd5b5061048b6105983660046137a2565b611105565b3480156105a8575f80fd5b5061048b6105b7366004613813565b61113d565b3480156105c7575f80fd5b506103d16105d63660046137a2565b600b60209081525f928352604080842090915290825290205460ff1681565b348015610600575f80fd5b5060145461060f9061ffff1681565b60405161ffff90911681526020016103a9565b34801561062d575f80fd5b5061048b6112b0565b348015610641575f80fd5b5061039f7f3204c940063673962b481a0395619b3dbbd137589c419e993978c1c71bcf68ec81565b348015610674575f80fd5b506103d1610683366004613879565b6112d2565b348015610693575f80fd5b5061039f7f5afc4ec52f9af4394c52f4d1fc6eaff0cea665d5cc56d61e00ea0a9ad47456ba81565b3480156106c6575f80fd5b506104ff611366565b61048b6106dd366004613918565b6113e4565b3480156106ed575f80fd5b5061039f6113ff565b348015610701575f80fd5b50604051600281526020016103a9565b34801561071c575f80fd5b5061039f61072b366004613879565b600f6020525f908152604090205481565b348015610747575f80fd5b5061048b610756366004613996565b61141a565b348015610766575f80fd5b505f805160206140608339815191525460ff166103d1565b348015610789575f80fd5b5061048b6114cb565b34801561079d575f80fd5b506107b16107ac366004613740565b61162c565b6040516103a99291906139be565b3480156107ca575f80fd5b5061039f60095481565b3480156107df575f80fd5b505f5460ff16610439565b3480156107f5575f80fd5b5061048b610804366004613a02565b6116de565b348015610814575f80fd5b5061048b610823366004613aa2565b6118ff565b348015610833575f80fd5b50600e546108419060ff1681565b6040516103a99190613af2565b348015610859575f80fd5b5061048b610868366004613b18565b611956565b348015610878575f80fd5b5061048b6119b3565b34801561088c575f80fd5b506108956119d2565b6040516103a99796959493929190613b36565b3480156108b3575f80fd5b5061048b6108c2366004613bca565b611a7b565b3480156108d2575f80fd5b5061048b6108e1366004613c36565b611c38565b3480156108f1575f80fd5b506103d16109003660046137a2565b611cd3565b348015610910575f80fd5b5061039f60135481565b348015610925575f80fd5b5061039f60125481565b34801561093a575f80fd5b5061039f610949366004613c5f565b611d09565b348015610959575f80fd5b5061048b610968366004613740565b611d85565b348015610978575f80fd5b506103d1610987366004613740565b60106020525f908152604090205460ff1681565b3480156109a6575f80fd5b5061039f5f81565b3480156109b9575f80fd5b506103d16109c83660046137a2565b611dd6565b3480156109d8575f80fd5b5061045f604051806040016040528060058152602001640352e302e360dc1b81525081565b348015610a08575f80fd5b5061048b610a17366004613ca2565b611e25565b348015610a27575f80fd5b50600c5461060f90610100900461ffff1681565b348015610a46575f80fd5b5061052b611e80565b348015610a5a575f80fd5b50610439601081565b348015610a6e575f80fd5b5061048b610a7d3660046137a2565b611ec9565b348015610a8d575f80fd5b5061048b610a9c366004613aa2565b611ee5565b348015610aac575f80fd5b5061039f5f8051602061402083398151915281565b348015610acc575f80fd5b5061048b610adb366004613ccb565b611f72565b348015610aeb575f80fd5b5061048b610afa366004613740565b612032565b348015610b0a575f80fd5b5060115461039f565b6007546006545f91610b2491613d1d565b905090565b5f6001600160e01b03198216637965db0b60e01b1480610b5957506301ffc9a760e01b6001600160e01b03198316145b92915050565b5f610b693361212c565b610b716121dd565b610b7961220f565b5f610b876201518085613d44565b610b919085613d1d565b90505f610ba16201518085613d44565b610bab9085613d1d565b90505f5b601154811015610c57578260118281548110610bcd57610bcd613d57565b905f5260205f20015410158015610bff57508160118281548110610bf357610bf3613d57565b905f5260205f20015411155b15610c455760118181548110610c1757610c17613d57565b905f5260205f2001546040516306c5265160e21b8152600401610c3c91815260200190565b60405180910390fd5b80610c4f81613d6b565b915050610baf565b506ec097ce7bc90715b34b9f1000000000825b828111610d6257610c7f601062015180613d83565b610c899082613d44565b158015610cb857508262015180610ca1601082613d83565b610cab9084613d9a565b610cb59190613d1d565b11155b15610d2d575f600881610ccf601062015180613d83565b610cd99085613dad565b81526020019081526020015f20549050805f14610d0d57610d0a83826ec097ce7bc90715b34b9f100000000061223c565b92505b610d1b601062015180613d83565b610d259083613d9a565b915050610c6a565b5f8181526002602052604081205490811315610d5557610d5283826305f5e10061223c565b92505b610d256201518083613d9a565b610d7f6305f5e1006ec097ce7bc90715b34b9f1000000000613dad565b610d899083613dad565b979650505050505050565b606060018054610da390613dc0565b80601f0160208091040260200160405190810160405280929190818152602001828054610dcf90613dc0565b8015610e1a5780601f10610df157610100808354040283529160200191610e1a565b820191905f5260205f20905b815481529060010190602001808311610dfd57829003601f168201915b5050505050905090565b5f805160206140a0833981519152610e3b816122fb565b5f610e496201518084613d44565b610e539084613d1d565b5f8181526002602052604081206001015491925003610e885760405163bd13fe9f60e01b815260048101829052602401610c3c565b5f8181526010602052604090205460ff1615610ea357505050565b5f818152601060209081526040808320805460ff191660019081179091556011805491820181559093527f31ecc21a745e3968a04e9570e4425bc18fa8019c68028196b546d1669c200c68909201839055905133815282917f8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515910160405180910390a2505b5050565b5f9081525f80516020614040833981519152602052604090206001015490565b604080516080810182525f808252602082018190529181018290526060810191909152610f783361212c565b610f806121dd565b5f610f8e6201518084613d44565b610f989084613d1d565b9050610fa381612305565b5f908152600260208181526040928390208351608081018552815481526001820154928101929092529091015460ff808216938301939093526101009004909116606082015290505b919050565b5f8080600d816110046201518087613d44565b61100e9087613d1d565b815260208101919091526040015f208054600290910154909560ff909116945092505050565b61103d82610f2c565b611046816122fb565b6110508383612337565b50505050565b5f6110603361212c565b6110686121dd565b5f6110766201518085613d44565b6110809085613d1d565b90505f6110906201518085613d44565b61109a9085613d1d565b90506305f5e100825b8281116110fb576110b381612305565b5f81815260026020526040812054126110e7575f818152600260205260409020546110e49083906305f5e10061223c565b91505b6110f46201518082613d9a565b90506110a3565b5095945050505050565b6001600160a01b038116331461112e5760405163334bd91960e11b815260040160405180910390fd5b61113882826123df565b505050565b5f611147816122fb565b838214611171576040516355c5b3e360e11b81526004810185905260248101839052604401610c3c565b5f5b848110156112a8576111b85f8051602061408083398151915287878481811061119e5761119e613d57565b90506020020160208101906111b39190613879565b612337565b508383828181106111cb576111cb613d57565b90506020020135600f5f8888858181106111e7576111e7613d57565b90506020020160208101906111fc9190613879565b6001600160a01b0316815260208101919091526040015f205585858281811061122757611227613d57565b905060200201602081019061123c9190613879565b6001600160a01b03167f4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce085858481811061127857611278613d57565b9050602002013560405161128e91815260200190565b60405180910390a2806112a081613d6b565b915050611173565b505050505050565b5f805160206140a08339815191526112c7816122fb565b6112cf612417565b50565b5f6001600e5460ff1660028111156112ec576112ec613ade565b036112f957506001919050565b6113105f8051602061408083398151915283611cd3565b61131b57505f919050565b6001600160a01b0382165f908152600f602052604081205490600e5460ff16600281111561134b5761134b613ade565b1480611355575080155b8061135f57508042105b9392505050565b604080516080810182525f8082526020820181905291810182905260608101919091526113923361212c565b61139a6121dd565b600a54156113ad576113ad600954612305565b50604080516080810182526003548152600454602082015260055460ff808216938301939093526101009004909116606082015290565b6113ec612476565b6113f58261251a565b610f288282612524565b5f6114086125e0565b505f8051602061400083398151915290565b5f611424816122fb565b601060ff8416118061143b57506127108261ffff16115b156114685760405163fee2146160e01b815260ff8416600482015261ffff83166024820152604401610c3c565b600c805460ff851662ffffff19909116811761010061ffff8616908102919091179092556040805191825260208201929092527ffbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556910160405180910390a1505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805460029190600160401b900460ff1680611514575080546001600160401b03808416911610155b156115325760405163f92ee8a960e01b815260040160405180910390fd5b805468ffffffffffffffffff19166001600160401b03831617600160401b1781555f61155d816122fb565b611565612629565b6115af6040518060400160405280600f81526020016e27b930b1b632a4b73234b1b0ba37b960891b815250604051806040016040528060018152602001603160f81b815250612639565b6115c65f8051602061402083398151915233612337565b506115de5f805160206140a083398151915233612337565b5050805460ff60401b191681556040516001600160401b03831681527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2906020015b60405180910390a15050565b604080516080810182525f8082526020820181905291810182905260608101829052906116583361264b565b61166d576116653361212c565b61166d6121dd565b5f61167b6201518085613d44565b6116859085613d1d565b5f908152600260208181526040808420601083529381902054815160808101835285548152600186015493810193909352939092015460ff81811693830193909352610100900482166060820152969116945092505050565b824211156117025760405163017f0c2160e61b815260048101849052602401610c3c565b5f6117108888888888611d09565b90505f806117538386868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061269092505050565b5090925090505f81600381111561176c5761176c613ade565b1461178a57604051638baa579f60e01b815260040160405180910390fd5b6117b47f3204c940063673962b481a0395619b3dbbd137589c419e993978c1c71bcf68ec83611cd3565b6117dc57604051633e3ad8f160e21b81526001600160a01b0383166004820152602401610c3c565b5f838152600b602090815260408083206001600160a01b038616845290915290205460ff161561183157604051634196a2bf60e01b8152600481018490526001600160a01b0383166024820152604401610c3c565b5f61183f620151808c613d44565b611849908c613d1d565b5f81815260026020526040902060010154909150801580159061186c5750808a11155b15611894576040516323a5987760e11b81526004810183905260248101829052604401610c3c565b5f858152600b602090815260408083206001600160a01b03881684529091529020805460ff19166001179055600c5460ff16156118dc576118d7848d8d8d6126d9565b6118f1565b6118e58b6128de565b6118f18c8c8c8c6129e6565b505050505050505050505050565b5f80516020614020833981519152611916816122fb565b600c5460ff161561193a57604051630ffcbb7160e31b815260040160405180910390fd5b611943846128de565b61194f858585856129e6565b5050505050565b5f611960816122fb565b600e805483919060ff1916600183600281111561197f5761197f613ade565b02179055507f17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56826040516116209190613af2565b5f805160206140a08339815191526119ca816122fb565b6112cf612b33565b5f60608082808083815f80516020613fe083398151915280549091501580156119fd57506001810154155b611a415760405162461bcd60e51b81526020600482015260156024820152741152540dcc4c8e88155b9a5b9a5d1a585b1a5e9959605a1b6044820152606401610c3c565b611a49612b7b565b611a51612c3b565b604080515f80825260208201909252600f60f81b9c939b5091995046985030975095509350915050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff1615906001600160401b03165f81158015611abf5750825b90505f826001600160401b03166001148015611ada5750303b155b905081158015611ae8575080155b15611b065760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff191660011785558315611b3057845460ff60401b1916600160401b1785555b611b38612c79565b611b40612629565b611b8a6040518060400160405280600f81526020016e27b930b1b632a4b73234b1b0ba37b960891b815250604051806040016040528060018152602001603160f81b815250612639565b611b92612c79565b5f805460ff191660ff89161790556001611bac8982613e3d565b50611bb75f87612337565b50611bcf5f8051602061402083398151915287612337565b50611be75f805160206140a083398151915287612337565b508315611c2e57845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050505050565b5f611c42816122fb565b82841315611c6d57604051630c06536560e31b81526004810185905260248101849052604401610c3c565b601284905560138390556014805461ffff191661ffff84169081179091556040805186815260208101869052908101919091527f42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf469060600160405180910390a150505050565b5f9182525f80516020614040833981519152602090815260408084206001600160a01b0393909316845291905290205460ff1690565b604080517f5afc4ec52f9af4394c52f4d1fc6eaff0cea665d5cc56d61e00ea0a9ad47456ba6020820152908101869052606081018590526080810184905260ff831660a082015260c081018290525f90611d7b9060e00160405160208183030381529060405280519060200120612c81565b9695505050505050565b5f805160206140a0833981519152611d9c816122fb565b5f611daa6201518084613d44565b611db49084613d1d565b5f8181526010602052604090205490915060ff16156111385761113881612cad565b5f600d81611de76201518086613d44565b611df19086613d1d565b815260208082019290925260409081015f9081206001600160a01b038616825260030190925290205460ff16905092915050565b7f3204c940063673962b481a0395619b3dbbd137589c419e993978c1c71bcf68ec611e4f816122fb565b600c5460ff165f03611e7457604051632b3c1cc960e11b815260040160405180910390fd5b611050338585856126d9565b6003546014545f9061ffff1615801590611e9b57505f600a54115b8015611ea657508115155b8015611ec357506009545f9081526010602052604090205460ff16155b90509091565b611ed282610f2c565b611edb816122fb565b61105083836123df565b5f611eef816122fb565b5f611efd6201518087613d44565b611f079087613d1d565b5f818152600d60205260409020600201805460ff191660011790559050611f30868686866129e6565b6040805186815233602082015282917f773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986910160405180910390a2505050505050565b5f611f7c816122fb565b5f5b8281101561105057611fc35f80516020614080833981519152858584818110611fa957611fa9613d57565b9050602002016020810190611fbe9190613879565b6123df565b50838382818110611fd657611fd6613d57565b9050602002016020810190611feb9190613879565b6001600160a01b03167f0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec60405160405180910390a28061202a81613d6b565b915050611f7e565b5f61203c816122fb565b6006546007545f8261204e8684613d9a565b116120625761205d8583613d9a565b612064565b825b90505b808210156120dc575f6006838154811061208357612083613d57565b5f9182526020822060029091020154600a805491935090916120a483613d6b565b909155505060098190555f818152600260205260409020546120c99082906001612d9f565b50816120d481613d6b565b925050612067565b60078190557f7f016eba225882e819c60c1ec893e0e40d3c09a119b62db3b78307de028dc8088161210d8186613d1d565b6040805192835260208301919091520160405180910390a15050505050565b6001600e5460ff16600281111561214557612145613ade565b0361214d5750565b6121645f8051602061408083398151915282612ebb565b6001600160a01b0381165f908152600f60205260409020546002600e5460ff16600281111561219557612195613ade565b1480156121a157508015155b80156121ad5750804210155b15610f285760405163204d73bb60e21b81526001600160a01b038316600482015260248101829052604401610c3c565b5f805160206140608339815191525460ff161561220d5760405163d93c066560e01b815260040160405180910390fd5b565b5f612218610b13565b905080156112cf57604051637a48de3b60e11b815260048101829052602401610c3c565b5f838302815f1985870982811083820303915050805f036122705783828161226657612266613d30565b049250505061135f565b8084116122905760405163227bc15360e01b815260040160405180910390fd5b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010186841190950394909402919094039290920491909117919091029150509392505050565b6112cf8133612ebb565b5f8181526010602052604090205460ff16156112cf576040516306c5265160e21b815260048101829052602401610c3c565b5f5f805160206140408339815191526123508484611cd3565b6123cf575f848152602082815260408083206001600160a01b03871684529091529020805460ff191660011790556123853390565b6001600160a01b0316836001600160a01b0316857f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a46001915050610b59565b5f915050610b59565b5092915050565b5f5f80516020614080833981519152830361240d576001600160a01b0382165f908152600f60205260408120555b61135f8383612ef4565b61241f612f6d565b5f80516020614060833981519152805460ff191681557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa335b6040516001600160a01b03909116815260200160405180910390a150565b306001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001614806124fc57507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166124f05f80516020614000833981519152546001600160a01b031690565b6001600160a01b031614155b1561220d5760405163703e46dd60e11b815260040160405180910390fd5b5f610f28816122fb565b816001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa92505050801561257e575060408051601f3d908101601f1916820190925261257b91810190613ef8565b60015b6125a657604051634c9c8ce360e01b81526001600160a01b0383166004820152602401610c3c565b5f8051602061400083398151915281146125d657604051632a87526960e21b815260048101829052602401610c3c565b6111388383612f9c565b306001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461220d5760405163703e46dd60e11b815260040160405180910390fd5b612631612ff1565b61220d61303a565b612641612ff1565b610f28828261305a565b5f6126568183611cd3565b8061267357506126735f8051602061402083398151915283611cd3565b80610b595750610b595f805160206140a083398151915283611cd3565b5f805f83516041036126c7576020840151604085015160608601515f1a6126b9888285856130b9565b9550955095505050506126d2565b505081515f91506002905b9250925092565b5f6126e76201518085613d44565b6126f19085613d1d565b5f818152600d6020526040902060028101549192509060ff161561272a576040516241ec1d60e41b815260048101839052602401610c3c565b6001600160a01b0386165f90815260038201602052604090205460ff161561277757604051636338a03760e11b8152600481018390526001600160a01b0387166024820152604401610c3c565b6001600160a01b0386165f90815260038201602090815260408220805460ff191660019081179091558354808201855584845291909220018590558101548311156127c457600181018390555b5f6127ce82613181565b90505f6127dc8283516132c1565b90505f805b83518110156128695761280d8482815181106127ff576127ff613d57565b60200260200101518461338e565b156128575783818151811061282457612824613d57565b602002602001015184838061283890613d6b565b94508151811061284a5761284a613d57565b6020026020010181815250505b8061286181613d6b565b9150506127e1565b50600c5460ff16811015612881575050505050611050565b5f61288c84836132c1565b9050612897816128de565b60028501805460ff1916600117905583515f906128b5846064613d83565b6128bf9190613dad565b90506128d187838860010154846129e6565b5050505050505050505050565b6012541515806128ef575060135415155b80156129075750601254811280612907575060135481135b1561293b5760125460135460405163e797616560e01b81526004810184905260248101929092526044820152606401610c3c565b5f80612945611e80565b915091508061295357505050565b5f82841361296a576129658484613f0f565b612974565b6129748385613f0f565b90505f8084121561298d5761298884613f2e565b61298f565b835b6014549091506129a39061ffff1682613d83565b6129af61271084613d83565b111561194f57601454604051630f6bd06560e11b8152600481018790526024810186905261ffff9091166044820152606401610c3c565b6129ee61220f565b5f6129fc6201518086613d44565b612a069086613d1d565b60408051608081018252868152602081018690525f805460ff908116938301939093529185166060820152600a54929350911580612a45575060095483115b5f8481526002602052604081206001015491925003612a7357600a8054905f612a6d83613d6b565b91905055505b5f83815260026020818152604092839020855181559085015160018201559184015191018054606085015160ff9081166101000261ffff199092169316929092179190911790556009548310612b0057600983905581516003556020820151600455604082015160058054606085015160ff9081166101000261ffff199092169316929092179190911790555b612b0b838783612d9f565b5f8381526010602052604090205460ff1615612b2a57612b2a83612cad565b50505050505050565b612b3b6121dd565b5f80516020614060833981519152805460ff191660011781557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a25833612458565b7fa16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d10280546060915f80516020613fe083398151915291612bb990613dc0565b80601f0160208091040260200160405190810160405280929190818152602001828054612be590613dc0565b8015612c305780601f10612c0757610100808354040283529160200191612c30565b820191905f5260205f20905b815481529060010190602001808311612c1357829003601f168201915b505050505091505090565b7fa16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d10380546060915f80516020613fe083398151915291612bb990613dc0565b61220d612ff1565b5f610b59612c8d6133fb565b8360405161190160f01b8152600281019290925260228201526042902090565b5f818152601060205260408120805460ff19169055601154905b81811015612d70578260118281548110612ce357612ce3613d57565b905f5260205f20015403612d5e576011612cfe600184613d1d565b81548110612d0e57612d0e613d57565b905f5260205f20015460118281548110612d2a57612d2a613d57565b5f918252602090912001556011805480612d4657612d46613f48565b600190038181905f5260205f20015f90559055612d70565b80612d6881613d6b565b915050612cc7565b5060405182907f736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4905f90a25050565b5f612dae601062015180613d83565b612db89085613dad565b5f818152600860205260409020549091508215612e0757805f03612de857506ec097ce7bc90715b34b9f10000000005b5f841315612e0257612dff81856305f5e10061223c565b90505b612e94565b506ec097ce7bc90715b34b9f10000000005f612e27601062015180613d83565b612e319084613d83565b9050805b612e43601062015180613d83565b612e4d9083613d9a565b811015612e91575f8181526002602052604081205490811315612e7c57612e7984826305f5e10061223c565b93505b50612e8a6201518082613d9a565b9050612e35565b50505b8015612ea05780612ea3565b60015b5f928352600860205260409092209190915550505050565b612ec58282611cd3565b610f285760405163e2517d3f60e01b81526001600160a01b038216600482015260248101839052604401610c3c565b5f5f80516020614040833981519152612f0d8484611cd3565b156123cf575f848152602082815260408083206001600160a01b0387168085529252808320805460ff1916905551339287917ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b9190a46001915050610b59565b5f805160206140608339815191525460ff1661220d57604051638dfc202b60e01b815260040160405180910390fd5b612fa582613404565b6040516001600160a01b038316907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b905f90a2805115612fe9576111388282613467565b610f286134d9565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff1661220d57604051631afcd79f60e31b815260040160405180910390fd5b613042612ff1565b5f80516020614060833981519152805460ff19169055565b613062612ff1565b5f80516020613fe08339815191527fa16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d10261309b8482613e3d565b50600381016130aa8382613e3d565b505f8082556001909101555050565b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156130f257505f91506003905082613177565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa158015613143573d5f803e3d5ffd5b5050604051601f1901519150506001600160a01b03811661316e57505f925060019150829050613177565b92505f91508190505b9450945094915050565b60605f828054806020026020016040519081016040528092919081815260200182805480156131cd57602002820191905f5260205f20905b8154815260200190600101908083116131b9575b50939450600193505050505b81518110156123d8575f8282815181106131f5576131f5613d57565b602002602001015190505f8290505b5f811180156132355750818461321b600184613d1d565b8151811061322b5761322b613d57565b6020026020010151135b1561328d5783613246600183613d1d565b8151811061325657613256613d57565b602002602001015184828151811061327057613270613d57565b60209081029190910101528061328581613f5c565b915050613204565b818482815181106132a0576132a0613d57565b602002602001018181525050505080806132b990613d6b565b9150506131d9565b5f806132ce600284613dad565b90506132db600284613d44565b600103613304578381815181106132f4576132f4613d57565b6020026020010151915050610b59565b600284613312600184613d1d565b8151811061332257613322613d57565b602002602001015185838151811061333c5761333c613d57565b602002602001015161334e9190613f0f565b6133589190613f71565b84613364600184613d1d565b8151811061337457613374613d57565b60200260200101516133869190613f9d565b949350505050565b5f808284136133a6576133a18484613f0f565b6133b0565b6133b08385613f0f565b90505f808412156133c9576133c484613f2e565b6133cb565b835b600c549091506133e490610100900461ffff1682613d83565b6133f061271084613d83565b111595945050505050565b5f610b246134f8565b806001600160a01b03163b5f0361343957604051634c9c8ce360e01b81526001600160a01b0382166004820152602401610c3c565b5f8051602061400083398151915280546001600160a01b0319166001600160a01b0392909216919091179055565b60605f80846001600160a01b0316846040516134839190613fc4565b5f60405180830381855af49150503d805f81146134bb576040519150601f19603f3d011682016040523d82523d5f602084013e6134c0565b606091505b50915091506134d085838361356b565b95945050505050565b341561220d5760405163b398979f60e01b815260040160405180910390fd5b5f7f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f6135226135c7565b61352a61362f565b60408051602081019490945283019190915260608201524660808201523060a082015260c00160405160208183030381529060405280519060200120905090565b6060826135805761357b82613671565b61135f565b815115801561359757506001600160a01b0384163b155b156135c057604051639996b31560e01b81526001600160a01b0385166004820152602401610c3c565b508061135f565b5f5f80516020613fe0833981519152816135df612b7b565b8051909150156135f757805160209091012092915050565b81548015613606579392505050565b7fc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470935050505090565b5f5f80516020613fe083398151915281613647612c3b565b80519091501561365f57805160209091012092915050565b60018201548015613606579392505050565b8051156136815780518082602001fd5b604051630a12f52160e11b815260040160405180910390fd5b5f602082840312156136aa575f80fd5b81356001600160e01b03198116811461135f575f80fd5b5f80604083850312156136d2575f80fd5b50508035926020909101359150565b5f5b838110156136fb5781810151838201526020016136e3565b50505f910152565b5f815180845261371a8160208601602086016136e1565b601f01601f19169290920160200192915050565b602081525f61135f6020830184613703565b5f60208284031215613750575f80fd5b5035919050565b60808101610b598284805182526020810151602083015260ff604082015116604083015260ff60608201511660608301525050565b80356001600160a01b0381168114610fec575f80fd5b5f80604083850312156137b3575f80fd5b823591506137c36020840161378c565b90509250929050565b5f8083601f8401126137dc575f80fd5b5081356001600160401b038111156137f2575f80fd5b6020830191508360208260051b850101111561380c575f80fd5b9250929050565b5f805f8060408587031215613826575f80fd5b84356001600160401b038082111561383c575f80fd5b613848888389016137cc565b90965094506020870135915080821115613860575f80fd5b5061386d878288016137cc565b95989497509550505050565b5f60208284031215613889575f80fd5b61135f8261378c565b634e487b7160e01b5f52604160045260245ffd5b5f6001600160401b03808411156138bf576138bf613892565b604051601f8501601f19908116603f011681019082821181831017156138e7576138e7613892565b816040528093508581528686860111156138ff575f80fd5b858560208301375f602087830101525050509392505050565b5f8060408385031215613929575f80fd5b6139328361378c565b915060208301356001600160401b0381111561394c575f80fd5b8301601f8101851361395c575f80fd5b61396b858235602084016138a6565b9150509250929050565b803560ff81168114610fec575f80fd5b803561ffff81168114610fec575f80fd5b5f80604083850312156139a7575f80fd5b6139b083613975565b91506137c360208401613985565b60a081016139f38285805182526020810151602083015260ff604082015116604083015260ff60608201511660608301525050565b82151560808301529392505050565b5f805f805f805f60c0888a031215613a18575f80fd5b873596506020880135955060408801359450613a3660608901613975565b93506080880135925060a08801356001600160401b0380821115613a58575f80fd5b818a0191508a601f830112613a6b575f80fd5b813581811115613a79575f80fd5b8b6020828501011115613a8a575f80fd5b60208301945080935050505092959891949750929550565b5f805f8060808587031215613ab5575f80fd5b843593506020850135925060408501359150613ad360608601613975565b905092959194509250565b634e487b7160e01b5f52602160045260245ffd5b6020810160038310613b1257634e487b7160e01b5f52602160045260245ffd5b91905290565b5f60208284031215613b28575f80fd5b81356003811061135f575f80fd5b60ff60f81b881681525f602060e081840152613b5560e084018a613703565b8381036040850152613b67818a613703565b606085018990526001600160a01b038816608086015260a0850187905284810360c086015285518082528387019250908301905f5b81811015613bb857835183529284019291840191600101613b9c565b50909c9b505050505050505050505050565b5f805f60608486031215613bdc575f80fd5b83356001600160401b03811115613bf1575f80fd5b8401601f81018613613c01575f80fd5b613c10868235602084016138a6565b935050613c1f60208501613975565b9150613c2d6040850161378c565b90509250925092565b5f805f60608486031215613c48575f80fd5b8335925060208401359150613c2d60408501613985565b5f805f805f60a08688031215613c73575f80fd5b853594506020860135935060408601359250613c9160608701613975565b949793965091946080013592915050565b5f805f60608486031215613cb4575f80fd5b505081359360208301359350604090920135919050565b5f8060208385031215613cdc575f80fd5b82356001600160401b03811115613cf1575f80fd5b613cfd858286016137cc565b90969095509350505050565b634e487b7160e01b5f52601160045260245ffd5b81810381811115610b5957610b59613d09565b634e487b7160e01b5f52601260045260245ffd5b5f82613d5257613d52613d30565b500690565b634e487b7160e01b5f52603260045260245ffd5b5f60018201613d7c57613d7c613d09565b5060010190565b8082028115828204841417610b5957610b59613d09565b80820180821115610b5957610b59613d09565b5f82613dbb57613dbb613d30565b500490565b600181811c90821680613dd457607f821691505b602082108103613df257634e487b7160e01b5f52602260045260245ffd5b50919050565b601f821115611138575f81815260208120601f850160051c81016020861015613e1e5750805b601f850160051c820191505b818110156112a857828155600101613e2a565b81516001600160401b03811115613e5657613e56613892565b613e6a81613e648454613dc0565b84613df8565b602080601f831160018114613e9d575f8415613e865750858301515b5f19600386901b1c1916600185901b1785556112a8565b5f85815260208120601f198616915b82811015613ecb57888601518255948401946001909101908401613eac565b5085821015613ee857878501515f19600388901b60f8161c191681555b5050505050600190811b01905550565b5f60208284031215613f08575f80fd5b5051919050565b8181035f8312801583831316838312821617156123d8576123d8613d09565b5f600160ff1b8201613f4257613f42613d09565b505f0390565b634e487b7160e01b5f52603160045260245ffd5b5f81613f6a57613f6a613d09565b505f190190565b5f82613f7f57613f7f613d30565b600160ff1b82145f1984141615613f9857613f98613d09565b500590565b8082018281125f831280158216821582161715613fbc57613fbc613d09565b505092915050565b5f8251613fd58184602087016136e1565b919091019291505056fea16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d100360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc0ac90c257048ef1c3e387c26d4a99bde06894efbcbff862dc1885c3a9319308a02dd7bc7dec4dceedda775e58dd541e08a116c6c53815c0bd028192f7b626800cd5ed15c6e187e77e9aee88184c21f4f2182ab5827cb3b7e07fbedcd63f03300b46ce43d76047f77f110931243fb48b444c01f8ce7d297bf5cdc21cb7634e00055435dd261a4b9b3364963f7738a7a662ad9c84396d64be3365284bb7f0a5041a2646970667358221220d179c2a5823176779f087187e54287111923b2a658c00a6c619488a927587fed64736f6c63430008150033",
+}
+
+// OracleIndicatorV2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use OracleIndicatorV2MetaData.ABI instead.
+var OracleIndicatorV2ABI = OracleIndicatorV2MetaData.ABI
+
+// OracleIndicatorV2Bin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use OracleIndicatorV2MetaData.Bin instead.
+var OracleIndicatorV2Bin = OracleIndicatorV2MetaData.Bin
+
+// DeployOracleIndicatorV2 deploys a new Ethereum contract, binding an instance of OracleIndicatorV2 to it.
+func DeployOracleIndicatorV2(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *OracleIndicatorV2, error) {
+	parsed, err := OracleIndicatorV2MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(OracleIndicatorV2Bin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &OracleIndicatorV2{OracleIndicatorV2Caller: OracleIndicatorV2Caller{contract: contract}, OracleIndicatorV2Transactor: OracleIndicatorV2Transactor{contract: contract}, OracleIndicatorV2Filterer: OracleIndicatorV2Filterer{contract: contract}}, nil
+}
+
+// OracleIndicatorV2 is an auto generated Go binding around an Ethereum contract.
+type OracleIndicatorV2 struct {
+	OracleIndicatorV2Caller     // Read-only binding to the contract
+	OracleIndicatorV2Transactor // Write-only binding to the contract
+	OracleIndicatorV2Filterer   // Log filterer for contract events
+}
+
+// OracleIndicatorV2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type OracleIndicatorV2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorV2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type OracleIndicatorV2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorV2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OracleIndicatorV2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OracleIndicatorV2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OracleIndicatorV2Session struct {
+	Contract     *OracleIndicatorV2 // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorV2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OracleIndicatorV2CallerSession struct {
+	Contract *OracleIndicatorV2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// OracleIndicatorV2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OracleIndicatorV2TransactorSession struct {
+	Contract     *OracleIndicatorV2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// OracleIndicatorV2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type OracleIndicatorV2Raw struct {
+	Contract *OracleIndicatorV2 // Generic contract binding to access the raw methods on
+}
+
+// OracleIndicatorV2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OracleIndicatorV2CallerRaw struct {
+	Contract *OracleIndicatorV2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// OracleIndicatorV2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OracleIndicatorV2TransactorRaw struct {
+	Contract *OracleIndicatorV2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOracleIndicatorV2 creates a new instance of OracleIndicatorV2, bound to a specific deployed contract.
+func NewOracleIndicatorV2(address common.Address, backend bind.ContractBackend) (*OracleIndicatorV2, error) {
+	contract, err := bindOracleIndicatorV2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2{OracleIndicatorV2Caller: OracleIndicatorV2Caller{contract: contract}, OracleIndicatorV2Transactor: OracleIndicatorV2Transactor{contract: contract}, OracleIndicatorV2Filterer: OracleIndicatorV2Filterer{contract: contract}}, nil
+}
+
+// NewOracleIndicatorV2Caller creates a new read-only instance of OracleIndicatorV2, bound to a specific deployed contract.
+func NewOracleIndicatorV2Caller(address common.Address, caller bind.ContractCaller) (*OracleIndicatorV2Caller, error) {
+	contract, err := bindOracleIndicatorV2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2Caller{contract: contract}, nil
+}
+
+// NewOracleIndicatorV2Transactor creates a new write-only instance of OracleIndicatorV2, bound to a specific deployed contract.
+func NewOracleIndicatorV2Transactor(address common.Address, transactor bind.ContractTransactor) (*OracleIndicatorV2Transactor, error) {
+	contract, err := bindOracleIndicatorV2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2Transactor{contract: contract}, nil
+}
+
+// NewOracleIndicatorV2Filterer creates a new log filterer instance of OracleIndicatorV2, bound to a specific deployed contract.
+func NewOracleIndicatorV2Filterer(address common.Address, filterer bind.ContractFilterer) (*OracleIndicatorV2Filterer, error) {
+	contract, err := bindOracleIndicatorV2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2Filterer{contract: contract}, nil
+}
+
+// bindOracleIndicatorV2 binds a generic wrapper to an already deployed contract.
+func bindOracleIndicatorV2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OracleIndicatorV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorV2 *OracleIndicatorV2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorV2.Contract.OracleIndicatorV2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorV2 *OracleIndicatorV2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.OracleIndicatorV2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorV2 *OracleIndicatorV2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.OracleIndicatorV2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OracleIndicatorV2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.contract.Transact(opts, method, params...)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) DEFAULTADMINROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.DEFAULTADMINROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.DEFAULTADMINROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GUARDIANROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "GUARDIAN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GUARDIANROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.GUARDIANROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// GUARDIANROLE is a free data retrieval call binding the contract method 0x24ea54f4.
+//
+// Solidity: function GUARDIAN_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GUARDIANROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.GUARDIANROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// MAXQUORUM is a free data retrieval call binding the contract method 0xd2cbc867.
+//
+// Solidity: function MAX_QUORUM() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) MAXQUORUM(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "MAX_QUORUM")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// MAXQUORUM is a free data retrieval call binding the contract method 0xd2cbc867.
+//
+// Solidity: function MAX_QUORUM() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) MAXQUORUM() (uint8, error) {
+	return _OracleIndicatorV2.Contract.MAXQUORUM(&_OracleIndicatorV2.CallOpts)
+}
+
+// MAXQUORUM is a free data retrieval call binding the contract method 0xd2cbc867.
+//
+// Solidity: function MAX_QUORUM() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) MAXQUORUM() (uint8, error) {
+	return _OracleIndicatorV2.Contract.MAXQUORUM(&_OracleIndicatorV2.CallOpts)
+}
+
+// PUBLISHERROLE is a free data retrieval call binding the contract method 0xd8f4b6fd.
+//
+// Solidity: function PUBLISHER_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) PUBLISHERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "PUBLISHER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PUBLISHERROLE is a free data retrieval call binding the contract method 0xd8f4b6fd.
+//
+// Solidity: function PUBLISHER_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) PUBLISHERROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.PUBLISHERROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// PUBLISHERROLE is a free data retrieval call binding the contract method 0xd8f4b6fd.
+//
+// Solidity: function PUBLISHER_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) PUBLISHERROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.PUBLISHERROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// READONLY is a free data retrieval call binding the contract method 0x15eecf21.
+//
+// Solidity: function READ_ONLY() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) READONLY(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "READ_ONLY")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// READONLY is a free data retrieval call binding the contract method 0x15eecf21.
+//
+// Solidity: function READ_ONLY() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) READONLY() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.READONLY(&_OracleIndicatorV2.CallOpts)
+}
+
+// READONLY is a free data retrieval call binding the contract method 0x15eecf21.
+//
+// Solidity: function READ_ONLY() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) READONLY() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.READONLY(&_OracleIndicatorV2.CallOpts)
+}
+
+// REPORTERROLE is a free data retrieval call binding the contract method 0x3f60d799.
+//
+// Solidity: function REPORTER_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) REPORTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "REPORTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REPORTERROLE is a free data retrieval call binding the contract method 0x3f60d799.
+//
+// Solidity: function REPORTER_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) REPORTERROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.REPORTERROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// REPORTERROLE is a free data retrieval call binding the contract method 0x3f60d799.
+//
+// Solidity: function REPORTER_ROLE() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) REPORTERROLE() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.REPORTERROLE(&_OracleIndicatorV2.CallOpts)
+}
+
+// REPORTTYPEHASH is a free data retrieval call binding the contract method 0x4a882fc3.
+//
+// Solidity: function REPORT_TYPEHASH() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) REPORTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "REPORT_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// REPORTTYPEHASH is a free data retrieval call binding the contract method 0x4a882fc3.
+//
+// Solidity: function REPORT_TYPEHASH() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) REPORTTYPEHASH() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.REPORTTYPEHASH(&_OracleIndicatorV2.CallOpts)
+}
+
+// REPORTTYPEHASH is a free data retrieval call binding the contract method 0x4a882fc3.
+//
+// Solidity: function REPORT_TYPEHASH() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) REPORTTYPEHASH() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.REPORTTYPEHASH(&_OracleIndicatorV2.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) UPGRADEINTERFACEVERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "UPGRADE_INTERFACE_VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) UPGRADEINTERFACEVERSION() (string, error) {
+	return _OracleIndicatorV2.Contract.UPGRADEINTERFACEVERSION(&_OracleIndicatorV2.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _OracleIndicatorV2.Contract.UPGRADEINTERFACEVERSION(&_OracleIndicatorV2.CallOpts)
+}
+
+// AccessMode is a free data retrieval call binding the contract method 0x7b5c6e28.
+//
+// Solidity: function accessMode() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) AccessMode(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "accessMode")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// AccessMode is a free data retrieval call binding the contract method 0x7b5c6e28.
+//
+// Solidity: function accessMode() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) AccessMode() (uint8, error) {
+	return _OracleIndicatorV2.Contract.AccessMode(&_OracleIndicatorV2.CallOpts)
+}
+
+// AccessMode is a free data retrieval call binding the contract method 0x7b5c6e28.
+//
+// Solidity: function accessMode() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) AccessMode() (uint8, error) {
+	return _OracleIndicatorV2.Contract.AccessMode(&_OracleIndicatorV2.CallOpts)
+}
+
+// CanRead is a free data retrieval call binding the contract method 0x42087d4f.
+//
+// Solidity: function canRead(address _account) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) CanRead(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "canRead", _account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CanRead is a free data retrieval call binding the contract method 0x42087d4f.
+//
+// Solidity: function canRead(address _account) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) CanRead(_account common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.CanRead(&_OracleIndicatorV2.CallOpts, _account)
+}
+
+// CanRead is a free data retrieval call binding the contract method 0x42087d4f.
+//
+// Solidity: function canRead(address _account) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) CanRead(_account common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.CanRead(&_OracleIndicatorV2.CallOpts, _account)
+}
+
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
+//
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) CheckpointCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "checkpointCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
+//
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) CheckpointCount() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.CheckpointCount(&_OracleIndicatorV2.CallOpts)
+}
+
+// CheckpointCount is a free data retrieval call binding the contract method 0x1f618cd2.
+//
+// Solidity: function checkpointCount() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) CheckpointCount() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.CheckpointCount(&_OracleIndicatorV2.CallOpts)
+}
+
+// ConsensusRound is a free data retrieval call binding the contract method 0x2c0af1ce.
+//
+// Solidity: function consensusRound(uint256 _timestamp) view returns(uint256 submissions, bool finalized)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) ConsensusRound(opts *bind.CallOpts, _timestamp *big.Int) (struct {
+	Submissions *big.Int
+	Finalized   bool
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "consensusRound", _timestamp)
+
+	outstruct := new(struct {
+		Submissions *big.Int
+		Finalized   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Submissions = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Finalized = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// ConsensusRound is a free data retrieval call binding the contract method 0x2c0af1ce.
+//
+// Solidity: function consensusRound(uint256 _timestamp) view returns(uint256 submissions, bool finalized)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) ConsensusRound(_timestamp *big.Int) (struct {
+	Submissions *big.Int
+	Finalized   bool
+}, error) {
+	return _OracleIndicatorV2.Contract.ConsensusRound(&_OracleIndicatorV2.CallOpts, _timestamp)
+}
+
+// ConsensusRound is a free data retrieval call binding the contract method 0x2c0af1ce.
+//
+// Solidity: function consensusRound(uint256 _timestamp) view returns(uint256 submissions, bool finalized)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) ConsensusRound(_timestamp *big.Int) (struct {
+	Submissions *big.Int
+	Finalized   bool
+}, error) {
+	return _OracleIndicatorV2.Contract.ConsensusRound(&_OracleIndicatorV2.CallOpts, _timestamp)
+}
+
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+//
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) Decimal(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "decimal")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+//
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Decimal() (uint8, error) {
+	return _OracleIndicatorV2.Contract.Decimal(&_OracleIndicatorV2.CallOpts)
+}
+
+// Decimal is a free data retrieval call binding the contract method 0x76809ce3.
+//
+// Solidity: function decimal() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) Decimal() (uint8, error) {
+	return _OracleIndicatorV2.Contract.Decimal(&_OracleIndicatorV2.CallOpts)
+}
+
+// DeviationBase is a free data retrieval call binding the contract method 0xd1607cdb.
+//
+// Solidity: function deviationBase() view returns(int256 value, bool active)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) DeviationBase(opts *bind.CallOpts) (struct {
+	Value  *big.Int
+	Active bool
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "deviationBase")
+
+	outstruct := new(struct {
+		Value  *big.Int
+		Active bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Active = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// DeviationBase is a free data retrieval call binding the contract method 0xd1607cdb.
+//
+// Solidity: function deviationBase() view returns(int256 value, bool active)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) DeviationBase() (struct {
+	Value  *big.Int
+	Active bool
+}, error) {
+	return _OracleIndicatorV2.Contract.DeviationBase(&_OracleIndicatorV2.CallOpts)
+}
+
+// DeviationBase is a free data retrieval call binding the contract method 0xd1607cdb.
+//
+// Solidity: function deviationBase() view returns(int256 value, bool active)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) DeviationBase() (struct {
+	Value  *big.Int
+	Active bool
+}, error) {
+	return _OracleIndicatorV2.Contract.DeviationBase(&_OracleIndicatorV2.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _OracleIndicatorV2.Contract.Eip712Domain(&_OracleIndicatorV2.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _OracleIndicatorV2.Contract.Eip712Domain(&_OracleIndicatorV2.CallOpts)
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetCumulativeInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getCumulativeInterval", _start, _end)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetCumulativeInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.GetCumulativeInterval(&_OracleIndicatorV2.CallOpts, _start, _end)
+}
+
+// GetCumulativeInterval is a free data retrieval call binding the contract method 0x0e5fa7f1.
+//
+// Solidity: function getCumulativeInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetCumulativeInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.GetCumulativeInterval(&_OracleIndicatorV2.CallOpts, _start, _end)
+}
+
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+//
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetDate(opts *bind.CallOpts, _timestamp *big.Int) (OracleIndicatorV2DataFeed, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getDate", _timestamp)
+
+	if err != nil {
+		return *new(OracleIndicatorV2DataFeed), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(OracleIndicatorV2DataFeed)).(*OracleIndicatorV2DataFeed)
+
+	return out0, err
+
+}
+
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+//
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetDate(_timestamp *big.Int) (OracleIndicatorV2DataFeed, error) {
+	return _OracleIndicatorV2.Contract.GetDate(&_OracleIndicatorV2.CallOpts, _timestamp)
+}
+
+// GetDate is a free data retrieval call binding the contract method 0x2b57298b.
+//
+// Solidity: function getDate(uint256 _timestamp) view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetDate(_timestamp *big.Int) (OracleIndicatorV2DataFeed, error) {
+	return _OracleIndicatorV2.Contract.GetDate(&_OracleIndicatorV2.CallOpts, _timestamp)
+}
+
+// GetDay is a free data retrieval call binding the contract method 0x65c72840.
+//
+// Solidity: function getDay(uint256 _timestamp) view returns((int256,uint256,uint8,uint8) feed, bool isRetracted)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetDay(opts *bind.CallOpts, _timestamp *big.Int) (struct {
+	Feed        OracleIndicatorV2DataFeed
+	IsRetracted bool
+}, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getDay", _timestamp)
+
+	outstruct := new(struct {
+		Feed        OracleIndicatorV2DataFeed
+		IsRetracted bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Feed = *abi.ConvertType(out[0], new(OracleIndicatorV2DataFeed)).(*OracleIndicatorV2DataFeed)
+	outstruct.IsRetracted = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetDay is a free data retrieval call binding the contract method 0x65c72840.
+//
+// Solidity: function getDay(uint256 _timestamp) view returns((int256,uint256,uint8,uint8) feed, bool isRetracted)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetDay(_timestamp *big.Int) (struct {
+	Feed        OracleIndicatorV2DataFeed
+	IsRetracted bool
+}, error) {
+	return _OracleIndicatorV2.Contract.GetDay(&_OracleIndicatorV2.CallOpts, _timestamp)
+}
+
+// GetDay is a free data retrieval call binding the contract method 0x65c72840.
+//
+// Solidity: function getDay(uint256 _timestamp) view returns((int256,uint256,uint8,uint8) feed, bool isRetracted)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetDay(_timestamp *big.Int) (struct {
+	Feed        OracleIndicatorV2DataFeed
+	IsRetracted bool
+}, error) {
+	return _OracleIndicatorV2.Contract.GetDay(&_OracleIndicatorV2.CallOpts, _timestamp)
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetInterval(opts *bind.CallOpts, _start *big.Int, _end *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getInterval", _start, _end)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.GetInterval(&_OracleIndicatorV2.CallOpts, _start, _end)
+}
+
+// GetInterval is a free data retrieval call binding the contract method 0x3488ecb3.
+//
+// Solidity: function getInterval(uint256 _start, uint256 _end) view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetInterval(_start *big.Int, _end *big.Int) (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.GetInterval(&_OracleIndicatorV2.CallOpts, _start, _end)
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetLast(opts *bind.CallOpts) (OracleIndicatorV2DataFeed, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getLast")
+
+	if err != nil {
+		return *new(OracleIndicatorV2DataFeed), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(OracleIndicatorV2DataFeed)).(*OracleIndicatorV2DataFeed)
+
+	return out0, err
+
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetLast() (OracleIndicatorV2DataFeed, error) {
+	return _OracleIndicatorV2.Contract.GetLast(&_OracleIndicatorV2.CallOpts)
+}
+
+// GetLast is a free data retrieval call binding the contract method 0x4d622831.
+//
+// Solidity: function getLast() view returns((int256,uint256,uint8,uint8))
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetLast() (OracleIndicatorV2DataFeed, error) {
+	return _OracleIndicatorV2.Contract.GetLast(&_OracleIndicatorV2.CallOpts)
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetName(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getName")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetName() (string, error) {
+	return _OracleIndicatorV2.Contract.GetName(&_OracleIndicatorV2.CallOpts)
+}
+
+// GetName is a free data retrieval call binding the contract method 0x17d7de7c.
+//
+// Solidity: function getName() view returns(string)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetName() (string, error) {
+	return _OracleIndicatorV2.Contract.GetName(&_OracleIndicatorV2.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.GetRoleAdmin(&_OracleIndicatorV2.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.GetRoleAdmin(&_OracleIndicatorV2.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.HasRole(&_OracleIndicatorV2.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.HasRole(&_OracleIndicatorV2.CallOpts, role, account)
+}
+
+// HasSubmitted is a free data retrieval call binding the contract method 0xa57d3806.
+//
+// Solidity: function hasSubmitted(uint256 _timestamp, address _reporter) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) HasSubmitted(opts *bind.CallOpts, _timestamp *big.Int, _reporter common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "hasSubmitted", _timestamp, _reporter)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasSubmitted is a free data retrieval call binding the contract method 0xa57d3806.
+//
+// Solidity: function hasSubmitted(uint256 _timestamp, address _reporter) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) HasSubmitted(_timestamp *big.Int, _reporter common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.HasSubmitted(&_OracleIndicatorV2.CallOpts, _timestamp, _reporter)
+}
+
+// HasSubmitted is a free data retrieval call binding the contract method 0xa57d3806.
+//
+// Solidity: function hasSubmitted(uint256 _timestamp, address _reporter) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) HasSubmitted(_timestamp *big.Int, _reporter common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.HasSubmitted(&_OracleIndicatorV2.CallOpts, _timestamp, _reporter)
+}
+
+// LastDay is a free data retrieval call binding the contract method 0x6b0c932d.
+//
+// Solidity: function lastDay() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) LastDay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "lastDay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastDay is a free data retrieval call binding the contract method 0x6b0c932d.
+//
+// Solidity: function lastDay() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) LastDay() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.LastDay(&_OracleIndicatorV2.CallOpts)
+}
+
+// LastDay is a free data retrieval call binding the contract method 0x6b0c932d.
+//
+// Solidity: function lastDay() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) LastDay() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.LastDay(&_OracleIndicatorV2.CallOpts)
+}
+
+// MaxDeviationBps is a free data retrieval call binding the contract method 0x3ee7a701.
+//
+// Solidity: function maxDeviationBps() view returns(uint16)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) MaxDeviationBps(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "maxDeviationBps")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// MaxDeviationBps is a free data retrieval call binding the contract method 0x3ee7a701.
+//
+// Solidity: function maxDeviationBps() view returns(uint16)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) MaxDeviationBps() (uint16, error) {
+	return _OracleIndicatorV2.Contract.MaxDeviationBps(&_OracleIndicatorV2.CallOpts)
+}
+
+// MaxDeviationBps is a free data retrieval call binding the contract method 0x3ee7a701.
+//
+// Solidity: function maxDeviationBps() view returns(uint16)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) MaxDeviationBps() (uint16, error) {
+	return _OracleIndicatorV2.Contract.MaxDeviationBps(&_OracleIndicatorV2.CallOpts)
+}
+
+// MaxValue is a free data retrieval call binding the contract method 0x94a5c2e4.
+//
+// Solidity: function maxValue() view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) MaxValue(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "maxValue")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxValue is a free data retrieval call binding the contract method 0x94a5c2e4.
+//
+// Solidity: function maxValue() view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) MaxValue() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.MaxValue(&_OracleIndicatorV2.CallOpts)
+}
+
+// MaxValue is a free data retrieval call binding the contract method 0x94a5c2e4.
+//
+// Solidity: function maxValue() view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) MaxValue() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.MaxValue(&_OracleIndicatorV2.CallOpts)
+}
+
+// MinValue is a free data retrieval call binding the contract method 0x963e63c7.
+//
+// Solidity: function minValue() view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) MinValue(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "minValue")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinValue is a free data retrieval call binding the contract method 0x963e63c7.
+//
+// Solidity: function minValue() view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) MinValue() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.MinValue(&_OracleIndicatorV2.CallOpts)
+}
+
+// MinValue is a free data retrieval call binding the contract method 0x963e63c7.
+//
+// Solidity: function minValue() view returns(int256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) MinValue() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.MinValue(&_OracleIndicatorV2.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Paused() (bool, error) {
+	return _OracleIndicatorV2.Contract.Paused(&_OracleIndicatorV2.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) Paused() (bool, error) {
+	return _OracleIndicatorV2.Contract.Paused(&_OracleIndicatorV2.CallOpts)
+}
+
+// PendingCheckpoints is a free data retrieval call binding the contract method 0x01b351f3.
+//
+// Solidity: function pendingCheckpoints() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) PendingCheckpoints(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "pendingCheckpoints")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingCheckpoints is a free data retrieval call binding the contract method 0x01b351f3.
+//
+// Solidity: function pendingCheckpoints() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) PendingCheckpoints() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.PendingCheckpoints(&_OracleIndicatorV2.CallOpts)
+}
+
+// PendingCheckpoints is a free data retrieval call binding the contract method 0x01b351f3.
+//
+// Solidity: function pendingCheckpoints() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) PendingCheckpoints() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.PendingCheckpoints(&_OracleIndicatorV2.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) ProxiableUUID() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.ProxiableUUID(&_OracleIndicatorV2.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) ProxiableUUID() ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.ProxiableUUID(&_OracleIndicatorV2.CallOpts)
+}
+
+// Quorum is a free data retrieval call binding the contract method 0x1703a018.
+//
+// Solidity: function quorum() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) Quorum(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "quorum")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Quorum is a free data retrieval call binding the contract method 0x1703a018.
+//
+// Solidity: function quorum() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Quorum() (uint8, error) {
+	return _OracleIndicatorV2.Contract.Quorum(&_OracleIndicatorV2.CallOpts)
+}
+
+// Quorum is a free data retrieval call binding the contract method 0x1703a018.
+//
+// Solidity: function quorum() view returns(uint8)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) Quorum() (uint8, error) {
+	return _OracleIndicatorV2.Contract.Quorum(&_OracleIndicatorV2.CallOpts)
+}
+
+// ReadExpiry is a free data retrieval call binding the contract method 0x55cc207b.
+//
+// Solidity: function readExpiry(address ) view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) ReadExpiry(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "readExpiry", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ReadExpiry is a free data retrieval call binding the contract method 0x55cc207b.
+//
+// Solidity: function readExpiry(address ) view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) ReadExpiry(arg0 common.Address) (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.ReadExpiry(&_OracleIndicatorV2.CallOpts, arg0)
+}
+
+// ReadExpiry is a free data retrieval call binding the contract method 0x55cc207b.
+//
+// Solidity: function readExpiry(address ) view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) ReadExpiry(arg0 common.Address) (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.ReadExpiry(&_OracleIndicatorV2.CallOpts, arg0)
+}
+
+// ReportDigest is a free data retrieval call binding the contract method 0x9b824d0c.
+//
+// Solidity: function reportDigest(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline) view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) ReportDigest(opts *bind.CallOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "reportDigest", _timestamp, _value, _updatedat, _confidence, _deadline)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ReportDigest is a free data retrieval call binding the contract method 0x9b824d0c.
+//
+// Solidity: function reportDigest(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline) view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) ReportDigest(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int) ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.ReportDigest(&_OracleIndicatorV2.CallOpts, _timestamp, _value, _updatedat, _confidence, _deadline)
+}
+
+// ReportDigest is a free data retrieval call binding the contract method 0x9b824d0c.
+//
+// Solidity: function reportDigest(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline) view returns(bytes32)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) ReportDigest(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int) ([32]byte, error) {
+	return _OracleIndicatorV2.Contract.ReportDigest(&_OracleIndicatorV2.CallOpts, _timestamp, _value, _updatedat, _confidence, _deadline)
+}
+
+// Retracted is a free data retrieval call binding the contract method 0x9fa2c776.
+//
+// Solidity: function retracted(uint256 ) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) Retracted(opts *bind.CallOpts, arg0 *big.Int) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "retracted", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Retracted is a free data retrieval call binding the contract method 0x9fa2c776.
+//
+// Solidity: function retracted(uint256 ) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Retracted(arg0 *big.Int) (bool, error) {
+	return _OracleIndicatorV2.Contract.Retracted(&_OracleIndicatorV2.CallOpts, arg0)
+}
+
+// Retracted is a free data retrieval call binding the contract method 0x9fa2c776.
+//
+// Solidity: function retracted(uint256 ) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) Retracted(arg0 *big.Int) (bool, error) {
+	return _OracleIndicatorV2.Contract.Retracted(&_OracleIndicatorV2.CallOpts, arg0)
+}
+
+// RetractedCount is a free data retrieval call binding the contract method 0xfac62972.
+//
+// Solidity: function retractedCount() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) RetractedCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "retractedCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RetractedCount is a free data retrieval call binding the contract method 0xfac62972.
+//
+// Solidity: function retractedCount() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) RetractedCount() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.RetractedCount(&_OracleIndicatorV2.CallOpts)
+}
+
+// RetractedCount is a free data retrieval call binding the contract method 0xfac62972.
+//
+// Solidity: function retractedCount() view returns(uint256)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) RetractedCount() (*big.Int, error) {
+	return _OracleIndicatorV2.Contract.RetractedCount(&_OracleIndicatorV2.CallOpts)
+}
+
+// SubmittedReports is a free data retrieval call binding the contract method 0x3ca956d8.
+//
+// Solidity: function submittedReports(bytes32 , address ) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) SubmittedReports(opts *bind.CallOpts, arg0 [32]byte, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "submittedReports", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SubmittedReports is a free data retrieval call binding the contract method 0x3ca956d8.
+//
+// Solidity: function submittedReports(bytes32 , address ) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SubmittedReports(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.SubmittedReports(&_OracleIndicatorV2.CallOpts, arg0, arg1)
+}
+
+// SubmittedReports is a free data retrieval call binding the contract method 0x3ca956d8.
+//
+// Solidity: function submittedReports(bytes32 , address ) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) SubmittedReports(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _OracleIndicatorV2.Contract.SubmittedReports(&_OracleIndicatorV2.CallOpts, arg0, arg1)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _OracleIndicatorV2.Contract.SupportsInterface(&_OracleIndicatorV2.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _OracleIndicatorV2.Contract.SupportsInterface(&_OracleIndicatorV2.CallOpts, interfaceId)
+}
+
+// ToleranceBps is a free data retrieval call binding the contract method 0xcd64f6fb.
+//
+// Solidity: function toleranceBps() view returns(uint16)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) ToleranceBps(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "toleranceBps")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// ToleranceBps is a free data retrieval call binding the contract method 0xcd64f6fb.
+//
+// Solidity: function toleranceBps() view returns(uint16)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) ToleranceBps() (uint16, error) {
+	return _OracleIndicatorV2.Contract.ToleranceBps(&_OracleIndicatorV2.CallOpts)
+}
+
+// ToleranceBps is a free data retrieval call binding the contract method 0xcd64f6fb.
+//
+// Solidity: function toleranceBps() view returns(uint16)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) ToleranceBps() (uint16, error) {
+	return _OracleIndicatorV2.Contract.ToleranceBps(&_OracleIndicatorV2.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint64)
+func (_OracleIndicatorV2 *OracleIndicatorV2Caller) Version(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _OracleIndicatorV2.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint64)
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Version() (uint64, error) {
+	return _OracleIndicatorV2.Contract.Version(&_OracleIndicatorV2.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(uint64)
+func (_OracleIndicatorV2 *OracleIndicatorV2CallerSession) Version() (uint64, error) {
+	return _OracleIndicatorV2.Contract.Version(&_OracleIndicatorV2.CallOpts)
+}
+
+// GrantReadAccess is a paid mutator transaction binding the contract method 0x39d80c27.
+//
+// Solidity: function grantReadAccess(address[] _accounts, uint256[] _expiries) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) GrantReadAccess(opts *bind.TransactOpts, _accounts []common.Address, _expiries []*big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "grantReadAccess", _accounts, _expiries)
+}
+
+// GrantReadAccess is a paid mutator transaction binding the contract method 0x39d80c27.
+//
+// Solidity: function grantReadAccess(address[] _accounts, uint256[] _expiries) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GrantReadAccess(_accounts []common.Address, _expiries []*big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.GrantReadAccess(&_OracleIndicatorV2.TransactOpts, _accounts, _expiries)
+}
+
+// GrantReadAccess is a paid mutator transaction binding the contract method 0x39d80c27.
+//
+// Solidity: function grantReadAccess(address[] _accounts, uint256[] _expiries) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) GrantReadAccess(_accounts []common.Address, _expiries []*big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.GrantReadAccess(&_OracleIndicatorV2.TransactOpts, _accounts, _expiries)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.GrantRole(&_OracleIndicatorV2.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.GrantRole(&_OracleIndicatorV2.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8c4d068a.
+//
+// Solidity: function initialize(string _name, uint8 _decimals, address _defaultAdmin) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) Initialize(opts *bind.TransactOpts, _name string, _decimals uint8, _defaultAdmin common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "initialize", _name, _decimals, _defaultAdmin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8c4d068a.
+//
+// Solidity: function initialize(string _name, uint8 _decimals, address _defaultAdmin) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Initialize(_name string, _decimals uint8, _defaultAdmin common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Initialize(&_OracleIndicatorV2.TransactOpts, _name, _decimals, _defaultAdmin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8c4d068a.
+//
+// Solidity: function initialize(string _name, uint8 _decimals, address _defaultAdmin) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) Initialize(_name string, _decimals uint8, _defaultAdmin common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Initialize(&_OracleIndicatorV2.TransactOpts, _name, _decimals, _defaultAdmin)
+}
+
+// InitializeV2 is a paid mutator transaction binding the contract method 0x5cd8a76b.
+//
+// Solidity: function initializeV2() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) InitializeV2(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "initializeV2")
+}
+
+// InitializeV2 is a paid mutator transaction binding the contract method 0x5cd8a76b.
+//
+// Solidity: function initializeV2() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) InitializeV2() (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.InitializeV2(&_OracleIndicatorV2.TransactOpts)
+}
+
+// InitializeV2 is a paid mutator transaction binding the contract method 0x5cd8a76b.
+//
+// Solidity: function initializeV2() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) InitializeV2() (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.InitializeV2(&_OracleIndicatorV2.TransactOpts)
+}
+
+// Invalidate is a paid mutator transaction binding the contract method 0x1ea1afdb.
+//
+// Solidity: function invalidate(uint256 _timestamp) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) Invalidate(opts *bind.TransactOpts, _timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "invalidate", _timestamp)
+}
+
+// Invalidate is a paid mutator transaction binding the contract method 0x1ea1afdb.
+//
+// Solidity: function invalidate(uint256 _timestamp) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Invalidate(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Invalidate(&_OracleIndicatorV2.TransactOpts, _timestamp)
+}
+
+// Invalidate is a paid mutator transaction binding the contract method 0x1ea1afdb.
+//
+// Solidity: function invalidate(uint256 _timestamp) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) Invalidate(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Invalidate(&_OracleIndicatorV2.TransactOpts, _timestamp)
+}
+
+// MigrateCheckpoints is a paid mutator transaction binding the contract method 0xf69e0f1c.
+//
+// Solidity: function migrateCheckpoints(uint256 _count) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) MigrateCheckpoints(opts *bind.TransactOpts, _count *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "migrateCheckpoints", _count)
+}
+
+// MigrateCheckpoints is a paid mutator transaction binding the contract method 0xf69e0f1c.
+//
+// Solidity: function migrateCheckpoints(uint256 _count) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) MigrateCheckpoints(_count *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.MigrateCheckpoints(&_OracleIndicatorV2.TransactOpts, _count)
+}
+
+// MigrateCheckpoints is a paid mutator transaction binding the contract method 0xf69e0f1c.
+//
+// Solidity: function migrateCheckpoints(uint256 _count) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) MigrateCheckpoints(_count *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.MigrateCheckpoints(&_OracleIndicatorV2.TransactOpts, _count)
+}
+
+// OverrideIndicator is a paid mutator transaction binding the contract method 0xd5c2d6fd.
+//
+// Solidity: function overrideIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) OverrideIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "overrideIndicator", _timestamp, _value, _updatedat, _confidence)
+}
+
+// OverrideIndicator is a paid mutator transaction binding the contract method 0xd5c2d6fd.
+//
+// Solidity: function overrideIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) OverrideIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.OverrideIndicator(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// OverrideIndicator is a paid mutator transaction binding the contract method 0xd5c2d6fd.
+//
+// Solidity: function overrideIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) OverrideIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.OverrideIndicator(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Pause() (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Pause(&_OracleIndicatorV2.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) Pause() (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Pause(&_OracleIndicatorV2.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.RenounceRole(&_OracleIndicatorV2.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.RenounceRole(&_OracleIndicatorV2.TransactOpts, role, callerConfirmation)
+}
+
+// Restore is a paid mutator transaction binding the contract method 0x9bea62ad.
+//
+// Solidity: function restore(uint256 _timestamp) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) Restore(opts *bind.TransactOpts, _timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "restore", _timestamp)
+}
+
+// Restore is a paid mutator transaction binding the contract method 0x9bea62ad.
+//
+// Solidity: function restore(uint256 _timestamp) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Restore(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Restore(&_OracleIndicatorV2.TransactOpts, _timestamp)
+}
+
+// Restore is a paid mutator transaction binding the contract method 0x9bea62ad.
+//
+// Solidity: function restore(uint256 _timestamp) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) Restore(_timestamp *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Restore(&_OracleIndicatorV2.TransactOpts, _timestamp)
+}
+
+// RevokeReadAccess is a paid mutator transaction binding the contract method 0xf2ac3f08.
+//
+// Solidity: function revokeReadAccess(address[] _accounts) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) RevokeReadAccess(opts *bind.TransactOpts, _accounts []common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "revokeReadAccess", _accounts)
+}
+
+// RevokeReadAccess is a paid mutator transaction binding the contract method 0xf2ac3f08.
+//
+// Solidity: function revokeReadAccess(address[] _accounts) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) RevokeReadAccess(_accounts []common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.RevokeReadAccess(&_OracleIndicatorV2.TransactOpts, _accounts)
+}
+
+// RevokeReadAccess is a paid mutator transaction binding the contract method 0xf2ac3f08.
+//
+// Solidity: function revokeReadAccess(address[] _accounts) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) RevokeReadAccess(_accounts []common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.RevokeReadAccess(&_OracleIndicatorV2.TransactOpts, _accounts)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.RevokeRole(&_OracleIndicatorV2.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.RevokeRole(&_OracleIndicatorV2.TransactOpts, role, account)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) SaveIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "saveIndicator", _timestamp, _value, _updatedat, _confidence)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SaveIndicator(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// SaveIndicator is a paid mutator transaction binding the contract method 0x77c6e440.
+//
+// Solidity: function saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) SaveIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SaveIndicator(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat, _confidence)
+}
+
+// SetAccessMode is a paid mutator transaction binding the contract method 0x7e31d2cc.
+//
+// Solidity: function setAccessMode(uint8 _mode) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) SetAccessMode(opts *bind.TransactOpts, _mode uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "setAccessMode", _mode)
+}
+
+// SetAccessMode is a paid mutator transaction binding the contract method 0x7e31d2cc.
+//
+// Solidity: function setAccessMode(uint8 _mode) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SetAccessMode(_mode uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SetAccessMode(&_OracleIndicatorV2.TransactOpts, _mode)
+}
+
+// SetAccessMode is a paid mutator transaction binding the contract method 0x7e31d2cc.
+//
+// Solidity: function setAccessMode(uint8 _mode) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) SetAccessMode(_mode uint8) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SetAccessMode(&_OracleIndicatorV2.TransactOpts, _mode)
+}
+
+// SetConsensus is a paid mutator transaction binding the contract method 0x5780f841.
+//
+// Solidity: function setConsensus(uint8 _quorum, uint16 _toleranceBps) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) SetConsensus(opts *bind.TransactOpts, _quorum uint8, _toleranceBps uint16) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "setConsensus", _quorum, _toleranceBps)
+}
+
+// SetConsensus is a paid mutator transaction binding the contract method 0x5780f841.
+//
+// Solidity: function setConsensus(uint8 _quorum, uint16 _toleranceBps) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SetConsensus(_quorum uint8, _toleranceBps uint16) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SetConsensus(&_OracleIndicatorV2.TransactOpts, _quorum, _toleranceBps)
+}
+
+// SetConsensus is a paid mutator transaction binding the contract method 0x5780f841.
+//
+// Solidity: function setConsensus(uint8 _quorum, uint16 _toleranceBps) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) SetConsensus(_quorum uint8, _toleranceBps uint16) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SetConsensus(&_OracleIndicatorV2.TransactOpts, _quorum, _toleranceBps)
+}
+
+// SetLimits is a paid mutator transaction binding the contract method 0x8fd92eab.
+//
+// Solidity: function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) SetLimits(opts *bind.TransactOpts, _min *big.Int, _max *big.Int, _maxDeviationBps uint16) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "setLimits", _min, _max, _maxDeviationBps)
+}
+
+// SetLimits is a paid mutator transaction binding the contract method 0x8fd92eab.
+//
+// Solidity: function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SetLimits(_min *big.Int, _max *big.Int, _maxDeviationBps uint16) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SetLimits(&_OracleIndicatorV2.TransactOpts, _min, _max, _maxDeviationBps)
+}
+
+// SetLimits is a paid mutator transaction binding the contract method 0x8fd92eab.
+//
+// Solidity: function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) SetLimits(_min *big.Int, _max *big.Int, _maxDeviationBps uint16) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SetLimits(&_OracleIndicatorV2.TransactOpts, _min, _max, _maxDeviationBps)
+}
+
+// SubmitSignedIndicator is a paid mutator transaction binding the contract method 0x76aad3fb.
+//
+// Solidity: function submitSignedIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline, bytes _signature) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) SubmitSignedIndicator(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int, _signature []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "submitSignedIndicator", _timestamp, _value, _updatedat, _confidence, _deadline, _signature)
+}
+
+// SubmitSignedIndicator is a paid mutator transaction binding the contract method 0x76aad3fb.
+//
+// Solidity: function submitSignedIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline, bytes _signature) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SubmitSignedIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int, _signature []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SubmitSignedIndicator(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat, _confidence, _deadline, _signature)
+}
+
+// SubmitSignedIndicator is a paid mutator transaction binding the contract method 0x76aad3fb.
+//
+// Solidity: function submitSignedIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence, uint256 _deadline, bytes _signature) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) SubmitSignedIndicator(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int, _confidence uint8, _deadline *big.Int, _signature []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SubmitSignedIndicator(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat, _confidence, _deadline, _signature)
+}
+
+// SubmitValue is a paid mutator transaction binding the contract method 0xbf48027c.
+//
+// Solidity: function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) SubmitValue(opts *bind.TransactOpts, _timestamp *big.Int, _value *big.Int, _updatedat *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "submitValue", _timestamp, _value, _updatedat)
+}
+
+// SubmitValue is a paid mutator transaction binding the contract method 0xbf48027c.
+//
+// Solidity: function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) SubmitValue(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SubmitValue(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat)
+}
+
+// SubmitValue is a paid mutator transaction binding the contract method 0xbf48027c.
+//
+// Solidity: function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) SubmitValue(_timestamp *big.Int, _value *big.Int, _updatedat *big.Int) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.SubmitValue(&_OracleIndicatorV2.TransactOpts, _timestamp, _value, _updatedat)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) Unpause() (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Unpause(&_OracleIndicatorV2.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) Unpause() (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.Unpause(&_OracleIndicatorV2.TransactOpts)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Transactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV2.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2Session) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.UpgradeToAndCall(&_OracleIndicatorV2.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_OracleIndicatorV2 *OracleIndicatorV2TransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _OracleIndicatorV2.Contract.UpgradeToAndCall(&_OracleIndicatorV2.TransactOpts, newImplementation, data)
+}
+
+// OracleIndicatorV2AccessModeChangedIterator is returned from FilterAccessModeChanged and is used to iterate over the raw logs and unpacked data for AccessModeChanged events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2AccessModeChangedIterator struct {
+	Event *OracleIndicatorV2AccessModeChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2AccessModeChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2AccessModeChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2AccessModeChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2AccessModeChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2AccessModeChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2AccessModeChanged represents a AccessModeChanged event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2AccessModeChanged struct {
+	Mode uint8
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterAccessModeChanged is a free log retrieval operation binding the contract event 0x17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56.
+//
+// Solidity: event AccessModeChanged(uint8 mode)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterAccessModeChanged(opts *bind.FilterOpts) (*OracleIndicatorV2AccessModeChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "AccessModeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2AccessModeChangedIterator{contract: _OracleIndicatorV2.contract, event: "AccessModeChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAccessModeChanged is a free log subscription operation binding the contract event 0x17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56.
+//
+// Solidity: event AccessModeChanged(uint8 mode)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchAccessModeChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2AccessModeChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "AccessModeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2AccessModeChanged)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "AccessModeChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAccessModeChanged is a log parse operation binding the contract event 0x17b7a5e093aa87177f7d661f25e6ecb36b8f5c948a837c6bbefb27f25b85be56.
+//
+// Solidity: event AccessModeChanged(uint8 mode)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseAccessModeChanged(log types.Log) (*OracleIndicatorV2AccessModeChanged, error) {
+	event := new(OracleIndicatorV2AccessModeChanged)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "AccessModeChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2CheckpointsMigratedIterator is returned from FilterCheckpointsMigrated and is used to iterate over the raw logs and unpacked data for CheckpointsMigrated events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2CheckpointsMigratedIterator struct {
+	Event *OracleIndicatorV2CheckpointsMigrated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2CheckpointsMigratedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2CheckpointsMigrated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2CheckpointsMigrated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2CheckpointsMigratedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2CheckpointsMigratedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2CheckpointsMigrated represents a CheckpointsMigrated event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2CheckpointsMigrated struct {
+	Migrated  *big.Int
+	Remaining *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterCheckpointsMigrated is a free log retrieval operation binding the contract event 0x7f016eba225882e819c60c1ec893e0e40d3c09a119b62db3b78307de028dc808.
+//
+// Solidity: event CheckpointsMigrated(uint256 migrated, uint256 remaining)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterCheckpointsMigrated(opts *bind.FilterOpts) (*OracleIndicatorV2CheckpointsMigratedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "CheckpointsMigrated")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2CheckpointsMigratedIterator{contract: _OracleIndicatorV2.contract, event: "CheckpointsMigrated", logs: logs, sub: sub}, nil
+}
+
+// WatchCheckpointsMigrated is a free log subscription operation binding the contract event 0x7f016eba225882e819c60c1ec893e0e40d3c09a119b62db3b78307de028dc808.
+//
+// Solidity: event CheckpointsMigrated(uint256 migrated, uint256 remaining)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchCheckpointsMigrated(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2CheckpointsMigrated) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "CheckpointsMigrated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2CheckpointsMigrated)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "CheckpointsMigrated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCheckpointsMigrated is a log parse operation binding the contract event 0x7f016eba225882e819c60c1ec893e0e40d3c09a119b62db3b78307de028dc808.
+//
+// Solidity: event CheckpointsMigrated(uint256 migrated, uint256 remaining)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseCheckpointsMigrated(log types.Log) (*OracleIndicatorV2CheckpointsMigrated, error) {
+	event := new(OracleIndicatorV2CheckpointsMigrated)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "CheckpointsMigrated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2ConsensusChangedIterator is returned from FilterConsensusChanged and is used to iterate over the raw logs and unpacked data for ConsensusChanged events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2ConsensusChangedIterator struct {
+	Event *OracleIndicatorV2ConsensusChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2ConsensusChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2ConsensusChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2ConsensusChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2ConsensusChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2ConsensusChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2ConsensusChanged represents a ConsensusChanged event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2ConsensusChanged struct {
+	Quorum       uint8
+	ToleranceBps uint16
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusChanged is a free log retrieval operation binding the contract event 0xfbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556.
+//
+// Solidity: event ConsensusChanged(uint8 quorum, uint16 toleranceBps)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterConsensusChanged(opts *bind.FilterOpts) (*OracleIndicatorV2ConsensusChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "ConsensusChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2ConsensusChangedIterator{contract: _OracleIndicatorV2.contract, event: "ConsensusChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusChanged is a free log subscription operation binding the contract event 0xfbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556.
+//
+// Solidity: event ConsensusChanged(uint8 quorum, uint16 toleranceBps)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchConsensusChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2ConsensusChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "ConsensusChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2ConsensusChanged)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "ConsensusChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusChanged is a log parse operation binding the contract event 0xfbced8859332fd8205226147824c02a89a9260f224670a78d314f5990275f556.
+//
+// Solidity: event ConsensusChanged(uint8 quorum, uint16 toleranceBps)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseConsensusChanged(log types.Log) (*OracleIndicatorV2ConsensusChanged, error) {
+	event := new(OracleIndicatorV2ConsensusChanged)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "ConsensusChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2EIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2EIP712DomainChangedIterator struct {
+	Event *OracleIndicatorV2EIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2EIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2EIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2EIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2EIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2EIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2EIP712DomainChanged represents a EIP712DomainChanged event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2EIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*OracleIndicatorV2EIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2EIP712DomainChangedIterator{contract: _OracleIndicatorV2.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2EIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2EIP712DomainChanged)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseEIP712DomainChanged(log types.Log) (*OracleIndicatorV2EIP712DomainChanged, error) {
+	event := new(OracleIndicatorV2EIP712DomainChanged)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2IndicatorInvalidatedIterator is returned from FilterIndicatorInvalidated and is used to iterate over the raw logs and unpacked data for IndicatorInvalidated events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2IndicatorInvalidatedIterator struct {
+	Event *OracleIndicatorV2IndicatorInvalidated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2IndicatorInvalidatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2IndicatorInvalidated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2IndicatorInvalidated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2IndicatorInvalidatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2IndicatorInvalidatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2IndicatorInvalidated represents a IndicatorInvalidated event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2IndicatorInvalidated struct {
+	Day     *big.Int
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterIndicatorInvalidated is a free log retrieval operation binding the contract event 0x8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515.
+//
+// Solidity: event IndicatorInvalidated(uint256 indexed day, address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterIndicatorInvalidated(opts *bind.FilterOpts, day []*big.Int) (*OracleIndicatorV2IndicatorInvalidatedIterator, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "IndicatorInvalidated", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2IndicatorInvalidatedIterator{contract: _OracleIndicatorV2.contract, event: "IndicatorInvalidated", logs: logs, sub: sub}, nil
+}
+
+// WatchIndicatorInvalidated is a free log subscription operation binding the contract event 0x8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515.
+//
+// Solidity: event IndicatorInvalidated(uint256 indexed day, address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchIndicatorInvalidated(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2IndicatorInvalidated, day []*big.Int) (event.Subscription, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "IndicatorInvalidated", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2IndicatorInvalidated)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "IndicatorInvalidated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIndicatorInvalidated is a log parse operation binding the contract event 0x8b2e4d1ac93bf7b2b37913558353772caa956b2188826f46d3c03922e8fd7515.
+//
+// Solidity: event IndicatorInvalidated(uint256 indexed day, address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseIndicatorInvalidated(log types.Log) (*OracleIndicatorV2IndicatorInvalidated, error) {
+	event := new(OracleIndicatorV2IndicatorInvalidated)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "IndicatorInvalidated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2IndicatorRestoredIterator is returned from FilterIndicatorRestored and is used to iterate over the raw logs and unpacked data for IndicatorRestored events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2IndicatorRestoredIterator struct {
+	Event *OracleIndicatorV2IndicatorRestored // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2IndicatorRestoredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2IndicatorRestored)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2IndicatorRestored)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2IndicatorRestoredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2IndicatorRestoredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2IndicatorRestored represents a IndicatorRestored event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2IndicatorRestored struct {
+	Day *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterIndicatorRestored is a free log retrieval operation binding the contract event 0x736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4.
+//
+// Solidity: event IndicatorRestored(uint256 indexed day)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterIndicatorRestored(opts *bind.FilterOpts, day []*big.Int) (*OracleIndicatorV2IndicatorRestoredIterator, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "IndicatorRestored", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2IndicatorRestoredIterator{contract: _OracleIndicatorV2.contract, event: "IndicatorRestored", logs: logs, sub: sub}, nil
+}
+
+// WatchIndicatorRestored is a free log subscription operation binding the contract event 0x736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4.
+//
+// Solidity: event IndicatorRestored(uint256 indexed day)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchIndicatorRestored(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2IndicatorRestored, day []*big.Int) (event.Subscription, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "IndicatorRestored", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2IndicatorRestored)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "IndicatorRestored", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIndicatorRestored is a log parse operation binding the contract event 0x736c7fa892f0b80870a5936f84b122a7f42348fce874309f4af5874a924a3ff4.
+//
+// Solidity: event IndicatorRestored(uint256 indexed day)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseIndicatorRestored(log types.Log) (*OracleIndicatorV2IndicatorRestored, error) {
+	event := new(OracleIndicatorV2IndicatorRestored)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "IndicatorRestored", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2InitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2InitializedIterator struct {
+	Event *OracleIndicatorV2Initialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2InitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2Initialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2Initialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2InitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2InitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2Initialized represents a Initialized event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2Initialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterInitialized(opts *bind.FilterOpts) (*OracleIndicatorV2InitializedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2InitializedIterator{contract: _OracleIndicatorV2.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2Initialized) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2Initialized)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseInitialized(log types.Log) (*OracleIndicatorV2Initialized, error) {
+	event := new(OracleIndicatorV2Initialized)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2LimitsChangedIterator is returned from FilterLimitsChanged and is used to iterate over the raw logs and unpacked data for LimitsChanged events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2LimitsChangedIterator struct {
+	Event *OracleIndicatorV2LimitsChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2LimitsChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2LimitsChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2LimitsChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2LimitsChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2LimitsChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2LimitsChanged represents a LimitsChanged event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2LimitsChanged struct {
+	MinValue        *big.Int
+	MaxValue        *big.Int
+	MaxDeviationBps uint16
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterLimitsChanged is a free log retrieval operation binding the contract event 0x42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf46.
+//
+// Solidity: event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterLimitsChanged(opts *bind.FilterOpts) (*OracleIndicatorV2LimitsChangedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "LimitsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2LimitsChangedIterator{contract: _OracleIndicatorV2.contract, event: "LimitsChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchLimitsChanged is a free log subscription operation binding the contract event 0x42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf46.
+//
+// Solidity: event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchLimitsChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2LimitsChanged) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "LimitsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2LimitsChanged)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "LimitsChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLimitsChanged is a log parse operation binding the contract event 0x42d59bb911f4e23114c60ec9ca4443603dac0ac7ec05048ee05e2a5ed52eaf46.
+//
+// Solidity: event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseLimitsChanged(log types.Log) (*OracleIndicatorV2LimitsChanged, error) {
+	event := new(OracleIndicatorV2LimitsChanged)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "LimitsChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2LimitsOverriddenIterator is returned from FilterLimitsOverridden and is used to iterate over the raw logs and unpacked data for LimitsOverridden events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2LimitsOverriddenIterator struct {
+	Event *OracleIndicatorV2LimitsOverridden // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2LimitsOverriddenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2LimitsOverridden)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2LimitsOverridden)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2LimitsOverriddenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2LimitsOverriddenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2LimitsOverridden represents a LimitsOverridden event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2LimitsOverridden struct {
+	Day     *big.Int
+	Value   *big.Int
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterLimitsOverridden is a free log retrieval operation binding the contract event 0x773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986.
+//
+// Solidity: event LimitsOverridden(uint256 indexed day, int256 value, address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterLimitsOverridden(opts *bind.FilterOpts, day []*big.Int) (*OracleIndicatorV2LimitsOverriddenIterator, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "LimitsOverridden", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2LimitsOverriddenIterator{contract: _OracleIndicatorV2.contract, event: "LimitsOverridden", logs: logs, sub: sub}, nil
+}
+
+// WatchLimitsOverridden is a free log subscription operation binding the contract event 0x773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986.
+//
+// Solidity: event LimitsOverridden(uint256 indexed day, int256 value, address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchLimitsOverridden(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2LimitsOverridden, day []*big.Int) (event.Subscription, error) {
+
+	var dayRule []interface{}
+	for _, dayItem := range day {
+		dayRule = append(dayRule, dayItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "LimitsOverridden", dayRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2LimitsOverridden)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "LimitsOverridden", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLimitsOverridden is a log parse operation binding the contract event 0x773d001da6dca06870b53315c4053ba581f67aec621e4731d4d4a0bfcb971986.
+//
+// Solidity: event LimitsOverridden(uint256 indexed day, int256 value, address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseLimitsOverridden(log types.Log) (*OracleIndicatorV2LimitsOverridden, error) {
+	event := new(OracleIndicatorV2LimitsOverridden)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "LimitsOverridden", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2PausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2PausedIterator struct {
+	Event *OracleIndicatorV2Paused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2PausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2Paused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2Paused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2PausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2PausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2Paused represents a Paused event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2Paused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterPaused(opts *bind.FilterOpts) (*OracleIndicatorV2PausedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2PausedIterator{contract: _OracleIndicatorV2.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2Paused) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2Paused)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParsePaused(log types.Log) (*OracleIndicatorV2Paused, error) {
+	event := new(OracleIndicatorV2Paused)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2ReadAccessGrantedIterator is returned from FilterReadAccessGranted and is used to iterate over the raw logs and unpacked data for ReadAccessGranted events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2ReadAccessGrantedIterator struct {
+	Event *OracleIndicatorV2ReadAccessGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2ReadAccessGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2ReadAccessGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2ReadAccessGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2ReadAccessGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2ReadAccessGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2ReadAccessGranted represents a ReadAccessGranted event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2ReadAccessGranted struct {
+	Account   common.Address
+	ExpiresAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterReadAccessGranted is a free log retrieval operation binding the contract event 0x4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0.
+//
+// Solidity: event ReadAccessGranted(address indexed account, uint256 expiresAt)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterReadAccessGranted(opts *bind.FilterOpts, account []common.Address) (*OracleIndicatorV2ReadAccessGrantedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "ReadAccessGranted", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2ReadAccessGrantedIterator{contract: _OracleIndicatorV2.contract, event: "ReadAccessGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchReadAccessGranted is a free log subscription operation binding the contract event 0x4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0.
+//
+// Solidity: event ReadAccessGranted(address indexed account, uint256 expiresAt)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchReadAccessGranted(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2ReadAccessGranted, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "ReadAccessGranted", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2ReadAccessGranted)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "ReadAccessGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReadAccessGranted is a log parse operation binding the contract event 0x4ea5721741a14fd85b4651b9cfc2061544914baff042f9e4980760331c5e1ce0.
+//
+// Solidity: event ReadAccessGranted(address indexed account, uint256 expiresAt)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseReadAccessGranted(log types.Log) (*OracleIndicatorV2ReadAccessGranted, error) {
+	event := new(OracleIndicatorV2ReadAccessGranted)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "ReadAccessGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2ReadAccessRevokedIterator is returned from FilterReadAccessRevoked and is used to iterate over the raw logs and unpacked data for ReadAccessRevoked events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2ReadAccessRevokedIterator struct {
+	Event *OracleIndicatorV2ReadAccessRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2ReadAccessRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2ReadAccessRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2ReadAccessRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2ReadAccessRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2ReadAccessRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2ReadAccessRevoked represents a ReadAccessRevoked event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2ReadAccessRevoked struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterReadAccessRevoked is a free log retrieval operation binding the contract event 0x0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec.
+//
+// Solidity: event ReadAccessRevoked(address indexed account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterReadAccessRevoked(opts *bind.FilterOpts, account []common.Address) (*OracleIndicatorV2ReadAccessRevokedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "ReadAccessRevoked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2ReadAccessRevokedIterator{contract: _OracleIndicatorV2.contract, event: "ReadAccessRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchReadAccessRevoked is a free log subscription operation binding the contract event 0x0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec.
+//
+// Solidity: event ReadAccessRevoked(address indexed account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchReadAccessRevoked(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2ReadAccessRevoked, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "ReadAccessRevoked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2ReadAccessRevoked)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "ReadAccessRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReadAccessRevoked is a log parse operation binding the contract event 0x0b07d2792db1ccd9a2578857818f7424daaee1f36a0605f9c2d4f2332a8485ec.
+//
+// Solidity: event ReadAccessRevoked(address indexed account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseReadAccessRevoked(log types.Log) (*OracleIndicatorV2ReadAccessRevoked, error) {
+	event := new(OracleIndicatorV2ReadAccessRevoked)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "ReadAccessRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2RoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2RoleAdminChangedIterator struct {
+	Event *OracleIndicatorV2RoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2RoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2RoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2RoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2RoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2RoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2RoleAdminChanged represents a RoleAdminChanged event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2RoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*OracleIndicatorV2RoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2RoleAdminChangedIterator{contract: _OracleIndicatorV2.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2RoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2RoleAdminChanged)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseRoleAdminChanged(log types.Log) (*OracleIndicatorV2RoleAdminChanged, error) {
+	event := new(OracleIndicatorV2RoleAdminChanged)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2RoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2RoleGrantedIterator struct {
+	Event *OracleIndicatorV2RoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2RoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2RoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2RoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2RoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2RoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2RoleGranted represents a RoleGranted event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2RoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*OracleIndicatorV2RoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2RoleGrantedIterator{contract: _OracleIndicatorV2.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2RoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2RoleGranted)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseRoleGranted(log types.Log) (*OracleIndicatorV2RoleGranted, error) {
+	event := new(OracleIndicatorV2RoleGranted)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2RoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2RoleRevokedIterator struct {
+	Event *OracleIndicatorV2RoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2RoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2RoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2RoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2RoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2RoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2RoleRevoked represents a RoleRevoked event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2RoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*OracleIndicatorV2RoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2RoleRevokedIterator{contract: _OracleIndicatorV2.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2RoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2RoleRevoked)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseRoleRevoked(log types.Log) (*OracleIndicatorV2RoleRevoked, error) {
+	event := new(OracleIndicatorV2RoleRevoked)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2UnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2UnpausedIterator struct {
+	Event *OracleIndicatorV2Unpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2UnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2Unpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2Unpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2UnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2UnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2Unpaused represents a Unpaused event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2Unpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterUnpaused(opts *bind.FilterOpts) (*OracleIndicatorV2UnpausedIterator, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2UnpausedIterator{contract: _OracleIndicatorV2.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2Unpaused) (event.Subscription, error) {
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2Unpaused)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseUnpaused(log types.Log) (*OracleIndicatorV2Unpaused, error) {
+	event := new(OracleIndicatorV2Unpaused)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OracleIndicatorV2UpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2UpgradedIterator struct {
+	Event *OracleIndicatorV2Upgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleIndicatorV2UpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleIndicatorV2Upgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleIndicatorV2Upgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleIndicatorV2UpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleIndicatorV2UpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleIndicatorV2Upgraded represents a Upgraded event raised by the OracleIndicatorV2 contract.
+type OracleIndicatorV2Upgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*OracleIndicatorV2UpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleIndicatorV2UpgradedIterator{contract: _OracleIndicatorV2.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *OracleIndicatorV2Upgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _OracleIndicatorV2.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleIndicatorV2Upgraded)
+				if err := _OracleIndicatorV2.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_OracleIndicatorV2 *OracleIndicatorV2Filterer) ParseUpgraded(log types.Log) (*OracleIndicatorV2Upgraded, error) {
+	event := new(OracleIndicatorV2Upgraded)
+	if err := _OracleIndicatorV2.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
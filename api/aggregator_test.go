@@ -0,0 +1,184 @@
+package api_test
+
+import (
+	"math/big"
+	"testing"
+
+	"abi/access"
+	"abi/api"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func deployAggregator(t *testing.T, chain *simtest.Chain) *api.OracleIndicatorAggregator {
+	t.Helper()
+	_, tx, aggregator, err := api.DeployOracleIndicatorAggregator(chain.Admin, chain.Client, chain.Address)
+	if err != nil {
+		t.Fatalf("deploy aggregator: %v", err)
+	}
+	receipt := chain.Mine(t, tx)
+
+	role, err := chain.Oracle.READONLY(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err = chain.Oracle.GrantRole(chain.Admin, role, receipt.ContractAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	return aggregator
+}
+
+func TestAggregatorMetadata(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	aggregator := deployAggregator(t, chain)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	decimals, err := aggregator.Decimals(opts)
+	if err != nil || decimals != 8 {
+		t.Fatalf("decimals() = %d, %v", decimals, err)
+	}
+	description, err := aggregator.Description(opts)
+	if err != nil || description != "CDI" {
+		t.Fatalf("description() = %q, %v", description, err)
+	}
+	version, err := aggregator.Version(opts)
+	if err != nil || version.Int64() != 1 {
+		t.Fatalf("version() = %v, %v", version, err)
+	}
+
+	_, err = aggregator.LatestRoundData(opts)
+	simtest.RequireRevert(t, err, "NoDataPresent")
+}
+
+func TestAggregatorMatchesDataFeed(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	aggregator := deployAggregator(t, chain)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	// published the next morning, with a weekend gap before the last day
+	days := []struct {
+		timestamp, value int64
+	}{
+		{day0, 4373900},
+		{day0 + day, 4373950},
+		{day0 + 4*day, 4374000},
+	}
+	for _, d := range days {
+		tx, err := chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(d.timestamp), big.NewInt(d.value), big.NewInt(d.timestamp+day+9*3600), 95)
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+	}
+
+	for _, d := range days {
+		feed, err := chain.Oracle.GetDate(opts, big.NewInt(d.timestamp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		round, err := aggregator.GetRoundData(opts, big.NewInt(d.timestamp/day))
+		if err != nil {
+			t.Fatalf("getRoundData(%d): %v", d.timestamp/day, api.DecodeError(err))
+		}
+		if round.RoundId.Int64() != d.timestamp/day || round.AnsweredInRound.Cmp(round.RoundId) != 0 {
+			t.Errorf("round ids %v/%v, want %d", round.RoundId, round.AnsweredInRound, d.timestamp/day)
+		}
+		if round.Answer.Cmp(feed.Value) != 0 || round.UpdatedAt.Cmp(feed.Updatedat) != 0 || round.StartedAt.Int64() != d.timestamp {
+			t.Errorf("round %v = (%v, %v, %v), data feed (%v, %v)", round.RoundId, round.Answer, round.StartedAt, round.UpdatedAt, feed.Value, feed.Updatedat)
+		}
+	}
+
+	// 2024-01-04 has no value
+	_, err := aggregator.GetRoundData(opts, big.NewInt((day0+2*day)/day))
+	simtest.RequireRevert(t, err, "NoDataPresent")
+
+	last, err := chain.Oracle.GetLast(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	latest, err := aggregator.LatestRoundData(opts)
+	if err != nil {
+		t.Fatalf("latestRoundData: %v", api.DecodeError(err))
+	}
+	if latest.RoundId.Int64() != (day0+4*day)/day || latest.Answer.Cmp(last.Value) != 0 || latest.UpdatedAt.Cmp(last.Updatedat) != 0 {
+		t.Errorf("latestRoundData = (%v, %v, %v), getLast = (%v, %v)", latest.RoundId, latest.Answer, latest.UpdatedAt, last.Value, last.Updatedat)
+	}
+}
+
+func TestAggregatorLatestRoundPublishedLate(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	aggregator := deployAggregator(t, chain)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	// a correction sent a month after the day, then a backfill of an earlier day
+	tx, err := chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(day0+4*day), big.NewInt(4374000), big.NewInt(day0+34*day), 95)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	save(t, chain, chain.Admin, day0, 4373900)
+
+	latest, err := aggregator.LatestRoundData(opts)
+	if err != nil {
+		t.Fatalf("latestRoundData: %v", api.DecodeError(err))
+	}
+	if latest.RoundId.Int64() != (day0+4*day)/day || latest.Answer.Int64() != 4374000 || latest.UpdatedAt.Int64() != day0+34*day {
+		t.Errorf("latestRoundData = (%v, %v, %v)", latest.RoundId, latest.Answer, latest.UpdatedAt)
+	}
+}
+
+func TestAggregatorNeedsReadOnly(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	grantReader(t, chain)
+	_, tx, aggregator, err := api.DeployOracleIndicatorAggregator(chain.Admin, chain.Client, chain.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	_, err = aggregator.LatestRoundData(chain.CallOpts(chain.Admin.From))
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+}
+
+// The adapter's own READ_ONLY does not stand in for the caller's
+func TestAggregatorChecksCaller(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	aggregator := deployAggregator(t, chain)
+	save(t, chain, chain.Admin, day0, 4373900)
+
+	_, reader := chain.NewAccount(t)
+	tx, err := chain.Oracle.GrantReadAccess(chain.Admin, []common.Address{reader.From}, []*big.Int{big.NewInt(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	if _, err := aggregator.LatestRoundData(chain.CallOpts(reader.From)); err != nil {
+		t.Fatalf("latestRoundData by a reader: %v", api.DecodeError(err))
+	}
+
+	tx, err = chain.Oracle.RevokeReadAccess(chain.Admin, []common.Address{reader.From})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	_, err = aggregator.LatestRoundData(chain.CallOpts(reader.From))
+	simtest.RequireRevert(t, err, "ReadAccessDenied")
+	_, err = aggregator.GetRoundData(chain.CallOpts(reader.From), big.NewInt(day0/day))
+	simtest.RequireRevert(t, err, "ReadAccessDenied")
+
+	// in public mode anyone reads, through the adapter as directly
+	tx, err = chain.Oracle.SetAccessMode(chain.Admin, access.Public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	if _, err := aggregator.GetRoundData(chain.CallOpts(reader.From), big.NewInt(day0/day)); err != nil {
+		t.Fatalf("getRoundData in public mode: %v", api.DecodeError(err))
+	}
+}
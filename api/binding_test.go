@@ -0,0 +1,63 @@
+package api
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"unicode"
+
+	"abi/bindgen"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Fails when a contract source, its pinned artifacts in build/ and its
+// binding drift apart. Fix with SOLC=solc go generate ./api.
+func TestBindingsMatchArtifacts(t *testing.T) {
+	bindings := map[string]*bind.MetaData{
+		"OracleIndicator":           OracleIndicatorMetaData,
+		"OracleIndicatorV1":         OracleIndicatorV1MetaData,
+		"OracleIndicatorV2":         OracleIndicatorV2MetaData,
+		"OracleIndicatorProxy":      OracleIndicatorProxyMetaData,
+		"OracleIndicatorAggregator": OracleIndicatorAggregatorMetaData,
+	}
+	for name, meta := range bindings {
+		t.Run(name, func(t *testing.T) {
+			artifact, err := bindgen.Load("../build", name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := artifact.CheckSources(".."); err != nil {
+				t.Errorf("artifacts are stale, recompile with SOLC=solc go generate ./api: %v", err)
+			}
+
+			// abigen drops every whitespace character from the embedded ABI
+			stripped := strings.Map(func(r rune) rune {
+				if unicode.IsSpace(r) {
+					return -1
+				}
+				return r
+			}, string(artifact.ABI))
+			if meta.ABI != stripped {
+				t.Errorf("binding ABI differs from build/%s.abi", name)
+			}
+			if meta.Bin != "0x"+artifact.Bin {
+				t.Errorf("binding bytecode differs from build/%s.bin", name)
+			}
+
+			want, err := artifact.Binding("api", name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(name + ".go")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s.go is not the output of go generate ./api", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package api_test
+
+import (
+	"math/big"
+	"testing"
+
+	"abi/api"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Deploys with consensus enabled and returns one funded reporter per entry
+func consensusChain(t *testing.T, reporters int, quorum uint8, toleranceBps uint16) (*simtest.Chain, []*bind.TransactOpts) {
+	t.Helper()
+	chain := simtest.New(t, "CDI", 6)
+	tx, err := chain.Oracle.SetConsensus(chain.Admin, quorum, toleranceBps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	role, err := chain.Oracle.REPORTERROLE(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var auths []*bind.TransactOpts
+	for i := 0; i < reporters; i++ {
+		_, auth := chain.NewAccount(t)
+		tx, err := chain.Oracle.GrantRole(chain.Admin, role, auth.From)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Mine(t, tx)
+		auths = append(auths, auth)
+	}
+	return chain, auths
+}
+
+func submit(t *testing.T, chain *simtest.Chain, auth *bind.TransactOpts, timestamp, value int64) {
+	t.Helper()
+	tx, err := chain.Oracle.SubmitValue(auth, big.NewInt(timestamp), big.NewInt(value), big.NewInt(timestamp+day))
+	if err != nil {
+		t.Fatalf("submitValue(%d, %d): %v", timestamp, value, api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+}
+
+func TestConsensusMedian(t *testing.T) {
+	tests := []struct {
+		name       string
+		quorum     uint8
+		tolerance  uint16
+		values     []int64
+		finalAfter int // submissions before the day is finalized, 0 if never
+		want       int64
+		confidence uint8
+	}{
+		{"unanimous", 2, 10, []int64{43739, 43739, 43739}, 2, 43739, 100},
+		{"within tolerance", 3, 100, []int64{1000, 1008, 1004}, 3, 1004, 100},
+		{"outlier ignored", 2, 10, []int64{43739, 45000, 43740}, 3, 43739, 66},
+		{"even agreeing count", 4, 50, []int64{10000, 20000, 10010, 10020, 10030}, 5, 10015, 80},
+		{"no agreement", 2, 10, []int64{43739, 45000, 47000}, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain, reporters := consensusChain(t, len(tt.values), tt.quorum, tt.tolerance)
+			grantReader(t, chain)
+			opts := chain.CallOpts(chain.Admin.From)
+
+			for i, value := range tt.values {
+				submit(t, chain, reporters[i], day0, value)
+				round, err := chain.Oracle.ConsensusRound(opts, big.NewInt(day0))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := tt.finalAfter != 0 && i+1 >= tt.finalAfter; round.Finalized != want {
+					t.Fatalf("after %d submissions finalized = %v", i+1, round.Finalized)
+				}
+				if round.Finalized {
+					break
+				}
+			}
+
+			feed, err := chain.Oracle.GetDate(opts, big.NewInt(day0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if feed.Value.Int64() != tt.want || feed.Confidence != tt.confidence {
+				t.Fatalf("stored (%v, confidence %d), want (%d, %d)", feed.Value, feed.Confidence, tt.want, tt.confidence)
+			}
+			if tt.finalAfter != 0 && feed.Updatedat.Int64() != day0+day {
+				t.Fatalf("updatedat %v", feed.Updatedat)
+			}
+		})
+	}
+}
+
+func TestConsensusRejections(t *testing.T) {
+	chain, reporters := consensusChain(t, 3, 2, 10)
+
+	submit(t, chain, reporters[0], day0, 43739)
+	_, err := chain.Oracle.SubmitValue(reporters[0], big.NewInt(day0+3600), big.NewInt(43739), big.NewInt(day0))
+	simtest.RequireRevert(t, err, "AlreadySubmitted")
+
+	submit(t, chain, reporters[1], day0, 43739)
+	_, err = chain.Oracle.SubmitValue(reporters[2], big.NewInt(day0), big.NewInt(43739), big.NewInt(day0))
+	simtest.RequireRevert(t, err, "DayAlreadyFinalized")
+
+	_, outsider := chain.NewAccount(t)
+	_, err = chain.Oracle.SubmitValue(outsider, big.NewInt(day0+day), big.NewInt(43739), big.NewInt(day0))
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+
+	// the publisher cannot bypass the reporters while consensus is on
+	_, err = chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(day0+day), big.NewInt(43739), big.NewInt(day0+day), 100)
+	simtest.RequireRevert(t, err, "ConsensusEnabled")
+
+	_, err = chain.Oracle.SetConsensus(chain.Admin, 17, 10)
+	simtest.RequireRevert(t, err, "InvalidConsensus")
+	_, err = chain.Oracle.SetConsensus(chain.Admin, 2, 10001)
+	simtest.RequireRevert(t, err, "InvalidConsensus")
+
+	tx, err := chain.Oracle.SetConsensus(chain.Admin, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	events, err := chain.Oracle.FilterConsensusChanged(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var changes []uint8
+	for events.Next() {
+		changes = append(changes, events.Event.Quorum)
+	}
+	if len(changes) != 2 || changes[0] != 2 || changes[1] != 0 {
+		t.Fatalf("ConsensusChanged quorums %v", changes)
+	}
+	_, err = chain.Oracle.SubmitValue(reporters[0], big.NewInt(day0+day), big.NewInt(43739), big.NewInt(day0))
+	simtest.RequireRevert(t, err, "ConsensusDisabled")
+}
+
+func TestConsensusMedianOutsideLimits(t *testing.T) {
+	chain, reporters := consensusChain(t, 2, 2, 10)
+	tx, err := chain.Oracle.SetLimits(chain.Admin, big.NewInt(40000), big.NewInt(50000), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	// the vote that would finalize an out-of-bounds median reverts and the day stays open
+	submit(t, chain, reporters[0], day0, 60000)
+	_, err = chain.Oracle.SubmitValue(reporters[1], big.NewInt(day0), big.NewInt(60000), big.NewInt(day0+day))
+	simtest.RequireRevert(t, err, "ValueOutOfBounds")
+
+	tx, err = chain.Oracle.OverrideIndicator(chain.Admin, big.NewInt(day0), big.NewInt(60000), big.NewInt(day0+day), 100)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	round, err := chain.Oracle.ConsensusRound(chain.CallOpts(chain.Admin.From), big.NewInt(day0))
+	if err != nil || !round.Finalized {
+		t.Fatalf("round after override = %+v, %v", round, err)
+	}
+	stored, err := chain.Oracle.GetDay(chain.CallOpts(chain.Admin.From), big.NewInt(day0))
+	if err != nil || stored.Feed.Value.Int64() != 60000 {
+		t.Fatalf("stored after override = %+v, %v", stored.Feed, err)
+	}
+}
@@ -0,0 +1,9 @@
+package api
+
+// Regenerates the bindings from the pinned artifacts in build/.
+// Set SOLC to a solc 0.8.21 executable to recompile the contracts first.
+//go:generate go run ../cmd/bindgen -solc=$SOLC -sol ../contract/OracleIndicator.sol -pkg api -out OracleIndicator.go
+//go:generate go run ../cmd/bindgen -solc=$SOLC -sol ../contract/OracleIndicatorV1.sol -pkg api -out OracleIndicatorV1.go
+//go:generate go run ../cmd/bindgen -solc=$SOLC -sol ../contract/OracleIndicatorV2.sol -pkg api -out OracleIndicatorV2.go
+//go:generate go run ../cmd/bindgen -solc=$SOLC -sol ../contract/OracleIndicatorProxy.sol -pkg api -out OracleIndicatorProxy.go
+//go:generate go run ../cmd/bindgen -solc=$SOLC -sol ../contract/OracleIndicatorAggregator.sol -pkg api -out OracleIndicatorAggregator.go
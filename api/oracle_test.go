@@ -0,0 +1,311 @@
+package api_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"abi/api"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+const day = 86400
+
+// 2024-01-02 00:00:00 UTC
+var day0 = int64(1704153600)
+
+func save(t *testing.T, chain *simtest.Chain, auth *bind.TransactOpts, timestamp, value int64) {
+	t.Helper()
+	tx, err := chain.Oracle.SaveIndicator(auth, big.NewInt(timestamp), big.NewInt(value), big.NewInt(timestamp), 100)
+	if err != nil {
+		t.Fatalf("saveIndicator(%d, %d): %v", timestamp, value, api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+}
+
+func grantReader(t *testing.T, chain *simtest.Chain) {
+	t.Helper()
+	role, err := chain.Oracle.READONLY(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := chain.Oracle.GrantRole(chain.Admin, role, chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+}
+
+func TestDeploy(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	name, err := chain.Oracle.GetName(opts)
+	if err != nil || name != "CDI" {
+		t.Fatalf("getName = %q, %v", name, err)
+	}
+	decimals, err := chain.Oracle.Decimal(opts)
+	if err != nil || decimals != 8 {
+		t.Fatalf("decimal = %d, %v", decimals, err)
+	}
+	admin, err := chain.Oracle.DEFAULTADMINROLE(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	has, err := chain.Oracle.HasRole(opts, admin, chain.Admin.From)
+	if err != nil || !has {
+		t.Fatalf("deployer is not admin: %v", err)
+	}
+	count, err := chain.Oracle.CheckpointCount(opts)
+	if err != nil || count.Sign() != 0 {
+		t.Fatalf("checkpointCount = %v, %v", count, err)
+	}
+}
+
+func TestRoleEnforcement(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	_, outsider := chain.NewAccount(t)
+
+	_, err := chain.Oracle.SaveIndicator(outsider, big.NewInt(day0), big.NewInt(1e8), big.NewInt(day0), 100)
+	revert := simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	if revert.Args[0] != outsider.From {
+		t.Errorf("revert names %v, want %v", revert.Args[0], outsider.From)
+	}
+
+	save(t, chain, chain.Admin, day0, 1e8)
+
+	// the admin does not hold READ_ONLY by default
+	_, err = chain.Oracle.GetLast(chain.CallOpts(chain.Admin.From))
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	_, err = chain.Oracle.GetLast(chain.CallOpts(outsider.From))
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+
+	grantReader(t, chain)
+	last, err := chain.Oracle.GetLast(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatalf("getLast after grant: %v", err)
+	}
+	if last.Value.Int64() != 1e8 {
+		t.Errorf("getLast value = %v, want 100000000", last.Value)
+	}
+}
+
+func TestPublisherRole(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	_, hot := chain.NewAccount(t)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	publisher, err := chain.Oracle.PUBLISHERROLE(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has, err := chain.Oracle.HasRole(opts, publisher, chain.Admin.From); err != nil || !has {
+		t.Fatalf("deployer holds PUBLISHER_ROLE = %v, %v", has, err)
+	}
+
+	tx, err := chain.Oracle.GrantRole(chain.Admin, publisher, hot.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	tx, err = chain.Oracle.RenounceRole(chain.Admin, publisher, chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	save(t, chain, hot, day0, 1e8)
+
+	// the publisher key cannot manage roles
+	_, outsider := chain.NewAccount(t)
+	_, err = chain.Oracle.GrantRole(hot, publisher, outsider.From)
+	revert := simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	if !strings.Contains(revert.Reason, "DEFAULT_ADMIN_ROLE") {
+		t.Errorf("grant by publisher: %s", revert.Reason)
+	}
+
+	// and the admin no longer publishes
+	_, err = chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(day0+day), big.NewInt(1e8), big.NewInt(day0+day), 100)
+	revert = simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	if !strings.Contains(revert.Reason, "PUBLISHER_ROLE") {
+		t.Errorf("save by admin: %s", revert.Reason)
+	}
+}
+
+func TestGetDateBuckets(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	save(t, chain, chain.Admin, day0+3600, 100_040_000)
+
+	for _, timestamp := range []int64{day0, day0 + 3600, day0 + day - 1} {
+		feed, err := chain.Oracle.GetDate(opts, big.NewInt(timestamp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if feed.Value.Int64() != 100_040_000 || feed.Decimal != 8 || feed.Confidence != 100 {
+			t.Errorf("getDate(%d) = %+v", timestamp, feed)
+		}
+	}
+	for _, timestamp := range []int64{day0 - 1, day0 + day} {
+		feed, err := chain.Oracle.GetDate(opts, big.NewInt(timestamp))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if feed.Updatedat.Sign() != 0 {
+			t.Errorf("getDate(%d) = %+v, want empty day", timestamp, feed)
+		}
+	}
+
+	// rewriting the same day replaces the value
+	save(t, chain, chain.Admin, day0+7200, 100_050_000)
+	feed, err := chain.Oracle.GetDate(opts, big.NewInt(day0))
+	if err != nil || feed.Value.Int64() != 100_050_000 {
+		t.Errorf("getDate after rewrite = %+v, %v", feed, err)
+	}
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	save(t, chain, chain.Admin, day0, 110_000_000)
+	save(t, chain, chain.Admin, day0+day, 120_000_000)
+	// day0+2*day is left empty
+	save(t, chain, chain.Admin, day0+3*day, 150_000_000)
+
+	tests := []struct {
+		name       string
+		start, end int64
+		legacy     int64
+		cumulative int64
+	}{
+		{"single day", day0, day0, 110_000_000, 110_000_000},
+		{"consecutive days", day0, day0 + day, 132_000_000, 132_000_000},
+		{"intra-day bounds", day0 + 100, day0 + day + 100, 132_000_000, 132_000_000},
+		{"gap", day0, day0 + 3*day, 0, 198_000_000},
+		{"after gap", day0 + 2*day, day0 + 3*day, 0, 150_000_000},
+		{"no data", day0 + 10*day, day0 + 11*day, 0, 100_000_000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := big.NewInt(tt.start), big.NewInt(tt.end)
+			legacy, err := chain.Oracle.GetInterval(opts, start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if legacy.Int64() != tt.legacy {
+				t.Errorf("getInterval = %v, want %d", legacy, tt.legacy)
+			}
+			cumulative, err := chain.Oracle.GetCumulativeInterval(opts, start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cumulative.Int64() != tt.cumulative {
+				t.Errorf("getCumulativeInterval = %v, want %d", cumulative, tt.cumulative)
+			}
+		})
+	}
+}
+
+func TestBackfillAndCorrection(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+	interval := func(start, end int64) int64 {
+		t.Helper()
+		value, err := chain.Oracle.GetCumulativeInterval(opts, big.NewInt(start), big.NewInt(end))
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		return value.Int64()
+	}
+
+	// an earlier day can be written after a later one without moving getLast
+	save(t, chain, chain.Admin, day0+day, 120_000_000)
+	save(t, chain, chain.Admin, day0, 110_000_000)
+	last, err := chain.Oracle.GetLast(opts)
+	if err != nil || last.Value.Int64() != 120_000_000 {
+		t.Fatalf("getLast after backfill = %+v, %v", last, err)
+	}
+	if got := interval(day0, day0+day); got != 132_000_000 {
+		t.Errorf("interval after backfill = %d", got)
+	}
+	save(t, chain, chain.Admin, day0, 100_000_000)
+	if got := interval(day0, day0+day); got != 120_000_000 {
+		t.Errorf("interval after correction = %d", got)
+	}
+
+	// corrections inside whole blocks of days are reflected in long intervals
+	for i := int64(2); i < 40; i++ {
+		save(t, chain, chain.Admin, day0+i*day, 100_000_000)
+	}
+	save(t, chain, chain.Admin, day0+20*day, 200_000_000)
+	save(t, chain, chain.Admin, day0+5*day, 300_000_000)
+	if got := interval(day0, day0+39*day); got != 720_000_000 {
+		t.Errorf("interval after corrections = %d, want 720000000", got)
+	}
+	save(t, chain, chain.Admin, day0+20*day, 100_000_000)
+	if got := interval(day0, day0+39*day); got != 360_000_000 {
+		t.Errorf("interval after reverting a correction = %d, want 360000000", got)
+	}
+	if count, err := chain.Oracle.CheckpointCount(opts); err != nil || count.Int64() != 40 {
+		t.Errorf("checkpointCount = %v, %v", count, err)
+	}
+}
+
+func TestCumulativeIntervalRealisticValues(t *testing.T) {
+	chain := simtest.New(t, "CDI", 6)
+	grantReader(t, chain)
+	opts := chain.CallOpts(chain.Admin.From)
+
+	// CDI as published: a daily rate of 0.043739% scaled by 1e6
+	for i := int64(0); i < 40; i++ {
+		save(t, chain, chain.Admin, day0+i*day, 43739)
+	}
+	for _, days := range []int64{1, 2, 3, 40} {
+		for _, start := range []int64{0, 15, 30} {
+			if start+days > 40 {
+				continue
+			}
+			from, to := big.NewInt(day0+start*day), big.NewInt(day0+(start+days-1)*day)
+			legacy, err := chain.Oracle.GetInterval(opts, from, to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cumulative, err := chain.Oracle.GetCumulativeInterval(opts, from, to)
+			if err != nil {
+				t.Fatalf("%d days from day %d: %v", days, start, api.DecodeError(err))
+			}
+			if cumulative.Cmp(legacy) != 0 {
+				t.Errorf("%d days from day %d: cumulative %v, legacy %v", days, start, cumulative, legacy)
+			}
+		}
+	}
+	single, _ := chain.Oracle.GetCumulativeInterval(opts, big.NewInt(day0+30*day), big.NewInt(day0+30*day))
+	pair, _ := chain.Oracle.GetCumulativeInterval(opts, big.NewInt(day0+30*day), big.NewInt(day0+31*day))
+	if single.Int64() != 43739 || pair.Int64() != 19 {
+		t.Errorf("single day = %v, two days = %v", single, pair)
+	}
+
+	// growth factors near 1 over many days match an exact product
+	const first, count = int64(100), int64(200)
+	want := new(big.Float).SetPrec(256).SetInt64(1e8)
+	for i := first; i < first+count; i++ {
+		value := int64(100_000_000 + 43_739 + i%7)
+		save(t, chain, chain.Admin, day0+i*day, value)
+		want.Mul(want, new(big.Float).SetPrec(256).Quo(big.NewFloat(float64(value)), big.NewFloat(1e8)))
+	}
+	got, err := chain.Oracle.GetCumulativeInterval(opts, big.NewInt(day0+first*day), big.NewInt(day0+(first+count-1)*day))
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	exact, _ := want.Int(nil)
+	if diff := new(big.Int).Sub(exact, got); diff.CmpAbs(big.NewInt(1)) > 0 {
+		t.Errorf("product over %d days = %v, want %v", count, got, exact)
+	}
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Role identifiers defined by the contract, used to name roles in revert messages
+var RoleNames = map[common.Hash]string{
+	{}: "DEFAULT_ADMIN_ROLE",
+	crypto.Keccak256Hash([]byte("READ_ONLY")):      "READ_ONLY",
+	crypto.Keccak256Hash([]byte("PUBLISHER_ROLE")): "PUBLISHER_ROLE",
+	crypto.Keccak256Hash([]byte("REPORTER_ROLE")):  "REPORTER_ROLE",
+	crypto.Keccak256Hash([]byte("GUARDIAN_ROLE")):  "GUARDIAN_ROLE",
+}
+
+// Role identifier for a name in RoleNames or a 0x-prefixed hash
+func RoleID(name string) (common.Hash, error) {
+	for id, known := range RoleNames {
+		if strings.EqualFold(known, name) {
+			return id, nil
+		}
+	}
+	if strings.HasPrefix(name, "0x") && len(name) == 66 {
+		return common.HexToHash(name), nil
+	}
+	return common.Hash{}, fmt.Errorf("unknown role %q", name)
+}
+
+// Selector of Panic(uint256)
+const panicSelector = "4e487b71"
+
+// Decoded revert of an OracleIndicator call or transaction
+type RevertError struct {
+	Name   string        // custom error name, "Error" for require messages or "Panic"
+	Args   []interface{} // decoded error arguments
+	Reason string        // human readable explanation
+	Data   []byte        // raw revert data
+}
+
+func (e *RevertError) Error() string {
+	return "execution reverted: " + e.Reason
+}
+
+// Contracts whose custom errors DecodeRevert recognises; the upgradeable
+// versions add the proxy, initializer and migration errors, the aggregator NoDataPresent
+var errorSources = []*bind.MetaData{OracleIndicatorMetaData, OracleIndicatorV1MetaData, OracleIndicatorV2MetaData, OracleIndicatorAggregatorMetaData}
+
+// Decodes revert data using the contracts' custom errors, falling back
+// to the standard Error(string) and Panic(uint256) encodings
+func DecodeRevert(data []byte) (*RevertError, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("revert data too short: %#x", data)
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		name := "Error"
+		if common.Bytes2Hex(data[:4]) == panicSelector {
+			name = "Panic"
+		}
+		return &RevertError{Name: name, Reason: reason, Data: data}, nil
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	var abiErr *abi.Error
+	for _, meta := range errorSources {
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		if abiErr, err = parsed.ErrorByID(selector); err == nil {
+			break
+		}
+	}
+	if abiErr == nil {
+		return nil, fmt.Errorf("unknown revert selector %#x", selector)
+	}
+
+	unpacked, err := abiErr.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %v", abiErr.Name, err)
+	}
+	args, _ := unpacked.([]interface{})
+
+	return &RevertError{Name: abiErr.Name, Args: args, Reason: describe(abiErr, args), Data: data}, nil
+}
+
+// Pulls the revert data out of an RPC error returned by eth_call or eth_estimateGas
+func RevertData(err error) ([]byte, bool) {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	switch data := dataErr.ErrorData().(type) {
+	case string:
+		raw, err := hexutil.Decode(data)
+		return raw, err == nil
+	case []byte:
+		return data, true
+	}
+	return nil, false
+}
+
+// Replaces an RPC error carrying revert data with the decoded RevertError,
+// returning the original error when it cannot be decoded
+func DecodeError(err error) error {
+	data, ok := RevertData(err)
+	if !ok {
+		return err
+	}
+	revert, decodeErr := DecodeRevert(data)
+	if decodeErr != nil {
+		return err
+	}
+	return revert
+}
+
+// Replays a mined transaction with eth_call on the state it executed against
+// (the parent of its block) and decodes why it reverted
+func ReplayRevert(ctx context.Context, caller interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}, tx *types.Transaction, receipt *types.Receipt) error {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("failed to recover sender: %v", err)
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	if tx.Type() == types.LegacyTxType || tx.Type() == types.AccessListTxType {
+		msg.GasPrice = tx.GasPrice()
+	} else {
+		msg.GasFeeCap = tx.GasFeeCap()
+		msg.GasTipCap = tx.GasTipCap()
+	}
+
+	block := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err = caller.CallContract(ctx, msg, block)
+	if err == nil {
+		return fmt.Errorf("transaction %s reverted but succeeds on replay (out of gas or state changed)", tx.Hash().Hex())
+	}
+	return DecodeError(err)
+}
+
+func describe(abiErr *abi.Error, args []interface{}) string {
+	switch abiErr.Name {
+	case "AccessControlUnauthorizedAccount":
+		if len(args) == 2 {
+			account, _ := args[0].(common.Address)
+			role, _ := args[1].([32]byte)
+			return fmt.Sprintf("signer %s lacks role %s", account.Hex(), roleName(role))
+		}
+	case "AccessControlBadConfirmation":
+		return "roles can only be renounced by the caller"
+	case "EnforcedPause":
+		return "contract is paused"
+	case "ExpectedPause":
+		return "contract is not paused"
+	}
+
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		if b, ok := arg.([32]byte); ok {
+			arg = common.Hash(b).Hex()
+		}
+		parts[i] = fmt.Sprint(arg)
+	}
+	return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(parts, ", "))
+}
+
+func roleName(role [32]byte) string {
+	hash := common.Hash(role)
+	if name, ok := RoleNames[hash]; ok {
+		return fmt.Sprintf("%s (%s)", name, hash.Hex())
+	}
+	return hash.Hex()
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EIP-1967 slot where a proxy stores its implementation address
+var ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// Contract name of each implementation version, as found in contract/ and build/.
+// Version 0 is the non-upgradeable OracleIndicator. Version 1 is the frozen
+// first proxy implementation: admin-published days in order and checkpointed
+// intervals. Version 2 carries every feature of OracleIndicator behind the proxy.
+var Versions = map[uint64]string{
+	0: "OracleIndicator",
+	1: "OracleIndicatorV1",
+	2: "OracleIndicatorV2",
+}
+
+// Node access needed to inspect a proxy
+type StorageCaller interface {
+	bind.ContractCaller
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Implementation address behind an EIP-1967 proxy, zero when address is not a proxy
+func Implementation(ctx context.Context, backend StorageCaller, proxy common.Address) (common.Address, error) {
+	value, err := backend.StorageAt(ctx, proxy, ImplementationSlot, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read implementation slot: %v", err)
+	}
+	return common.BytesToAddress(value), nil
+}
+
+// Implementation version live at address. Every upgradeable version exposes
+// version(); the non-upgradeable contract has no such function and reports 0.
+func LiveVersion(ctx context.Context, backend bind.ContractCaller, address common.Address) (uint64, error) {
+	caller, err := NewOracleIndicatorV1Caller(address, backend)
+	if err != nil {
+		return 0, err
+	}
+	version, err := caller.Version(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read version: %v", err)
+	}
+	return version, nil
+}
+
+// Contract name of the implementation live at address
+func LiveContract(ctx context.Context, backend bind.ContractCaller, address common.Address) (string, uint64, error) {
+	version, err := LiveVersion(ctx, backend, address)
+	if err != nil {
+		return "", 0, err
+	}
+	name, ok := Versions[version]
+	if !ok {
+		return "", version, fmt.Errorf("%s runs implementation version %d, which this build has no binding for", address.Hex(), version)
+	}
+	return name, version, nil
+}
+
+// Role saveIndicator requires at address: PUBLISHER_ROLE, or DEFAULT_ADMIN_ROLE
+// on deployments that predate it and have no PUBLISHER_ROLE() getter
+func PublisherRole(opts *bind.CallOpts, backend bind.ContractCaller, address common.Address) (common.Hash, error) {
+	caller, err := NewOracleIndicatorCaller(address, backend)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	role, err := caller.PUBLISHERROLE(opts)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return common.Hash{}, nil
+		}
+		return common.Hash{}, fmt.Errorf("failed to read PUBLISHER_ROLE: %v", err)
+	}
+	return role, nil
+}
+
+// Day stored at address and whether it is retracted, through getDay, which
+// needs a reader or operator role. Deployments that predate it expose the
+// indicators mapping instead, and those that predate retraction report none.
+func ReadDay(opts *bind.CallOpts, backend bind.ContractCaller, address common.Address, timestamp *big.Int) (OracleIndicatorDataFeed, bool, error) {
+	caller, err := NewOracleIndicatorCaller(address, backend)
+	if err != nil {
+		return OracleIndicatorDataFeed{}, false, err
+	}
+	day, err := caller.GetDay(opts, timestamp)
+	if err == nil {
+		return day.Feed, day.IsRetracted, nil
+	}
+	// a missing function reverts without data, a denied read with a custom error
+	var revert *RevertError
+	if errors.As(DecodeError(err), &revert) || !strings.Contains(err.Error(), "execution reverted") {
+		return OracleIndicatorDataFeed{}, false, DecodeError(err)
+	}
+
+	legacy, err := NewOracleIndicatorV1Caller(address, backend)
+	if err != nil {
+		return OracleIndicatorDataFeed{}, false, err
+	}
+	stored, err := legacy.Indicators(opts, timestamp)
+	if err != nil {
+		return OracleIndicatorDataFeed{}, false, err
+	}
+	feed := OracleIndicatorDataFeed{Value: stored.Value, Updatedat: stored.Updatedat, Decimal: stored.Decimal, Confidence: stored.Confidence}
+	retracted, err := caller.Retracted(opts, timestamp)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return feed, false, nil
+		}
+		return OracleIndicatorDataFeed{}, false, err
+	}
+	return feed, retracted, nil
+}
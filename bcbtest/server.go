@@ -0,0 +1,272 @@
+package bcbtest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	source "abi/json"
+)
+
+// Series served by every new server, keyed by SGS code
+//
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// The real API refuses larger "ultimos" requests
+const maxLast = 20
+
+const dateLayout = "02/01/2006"
+
+var seriesPath = regexp.MustCompile(`^/dados/serie/bcdata\.sgs\.(\d+)/dados(?:/ultimos/(\d+))?$`)
+
+// Kind of failure injected into a response
+type Fault int
+
+const (
+	FaultNone        Fault = iota
+	FaultServerError       // 500 with a JSON error body
+	FaultSlow              // waits Delay before answering normally
+	FaultHTML              // 200 with a text/html maintenance page
+	FaultMalformed         // 200 with a body that is not JSON
+	FaultTruncated         // declares the full Content-Length but sends half the body
+	FaultRateLimited       // 429 with Retry-After
+)
+
+// Failure applied to one request
+type Failure struct {
+	Fault      Fault
+	Delay      time.Duration // for FaultSlow
+	RetryAfter int           // seconds, for FaultRateLimited
+}
+
+// Fake SGS API backed by in-memory series
+type Server struct {
+	*httptest.Server
+
+	// Sent as Last-Modified on successful responses when not zero
+	LastModified time.Time
+
+	mu       sync.Mutex
+	series   map[int][]source.Data
+	failures []Failure
+	always   *Failure
+	requests int
+}
+
+// Starts a server preloaded with the fixture series. Close it when done.
+func NewServer() *Server {
+	s := &Server{series: make(map[int][]source.Data)}
+	entries, err := fixtures.ReadDir("fixtures")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		var code int
+		if _, err := fmt.Sscanf(entry.Name(), "sgs.%d.json", &code); err != nil {
+			panic(fmt.Sprintf("bcbtest: bad fixture name %s", entry.Name()))
+		}
+		body, err := fixtures.ReadFile(path.Join("fixtures", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var data []source.Data
+		if err := json.Unmarshal(body, &data); err != nil {
+			panic(fmt.Sprintf("bcbtest: fixture %s: %v", entry.Name(), err))
+		}
+		s.series[code] = data
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Replaces or adds the series served under code
+func (s *Server) SetSeries(code int, data []source.Data) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.series[code] = append([]source.Data(nil), data...)
+}
+
+// Queues failures for the next requests, one per request
+func (s *Server) Fail(failures ...Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failures...)
+}
+
+// Applies the failure to every request once the queue is empty
+func (s *Server) FailAlways(failure Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.always = &failure
+}
+
+// Clears queued and permanent failures
+func (s *Server) Recover() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+	s.always = nil
+}
+
+// Number of requests received so far
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Full series endpoint
+func (s *Server) SeriesURL(code int) string {
+	return fmt.Sprintf("%s/dados/serie/bcdata.sgs.%d/dados?formato=json", s.URL, code)
+}
+
+// Date window endpoint, bounds inclusive
+func (s *Server) WindowURL(code int, start, end time.Time) string {
+	return fmt.Sprintf("%s&dataInicial=%s&dataFinal=%s", s.SeriesURL(code), start.Format(dateLayout), end.Format(dateLayout))
+}
+
+// Endpoint for the last n observations
+func (s *Server) LastURL(code, n int) string {
+	return fmt.Sprintf("%s/dados/serie/bcdata.sgs.%d/dados/ultimos/%d?formato=json", s.URL, code, n)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	failure := Failure{}
+	if len(s.failures) > 0 {
+		failure = s.failures[0]
+		s.failures = s.failures[1:]
+	} else if s.always != nil {
+		failure = *s.always
+	}
+	s.mu.Unlock()
+
+	switch failure.Fault {
+	case FaultServerError:
+		writeError(w, http.StatusInternalServerError, "Internal Server Error")
+		return
+	case FaultRateLimited:
+		w.Header().Set("Retry-After", strconv.Itoa(failure.RetryAfter))
+		writeError(w, http.StatusTooManyRequests, "Too Many Requests")
+		return
+	case FaultHTML:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, "<html><head><title>Manutenção</title></head><body><h1>Serviço temporariamente indisponível</h1></body></html>")
+		return
+	case FaultMalformed:
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"data":"02/01/2024","valor":"0.043739"},{"data":`)
+		return
+	case FaultSlow:
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(failure.Delay):
+		}
+	}
+
+	data, status, msg := s.query(r)
+	if status != http.StatusOK {
+		writeError(w, status, msg)
+		return
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !s.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", s.LastModified.UTC().Format(http.TimeFormat))
+	}
+	if failure.Fault == FaultTruncated {
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(body[:len(body)/2])
+		// drop the connection without sending the rest of the body
+		panic(http.ErrAbortHandler)
+	}
+	w.Write(body)
+}
+
+// Resolves the request to the observations it selects
+func (s *Server) query(r *http.Request) ([]source.Data, int, string) {
+	match := seriesPath.FindStringSubmatch(r.URL.Path)
+	if match == nil {
+		return nil, http.StatusNotFound, "Not Found"
+	}
+	if format := r.URL.Query().Get("formato"); format != "" && !strings.EqualFold(format, "json") {
+		return nil, http.StatusBadRequest, "Formato inválido"
+	}
+
+	code, _ := strconv.Atoi(match[1])
+	s.mu.Lock()
+	series, ok := s.series[code]
+	s.mu.Unlock()
+	if !ok {
+		return nil, http.StatusNotFound, fmt.Sprintf("Série %d não encontrada", code)
+	}
+
+	if match[2] != "" {
+		n, _ := strconv.Atoi(match[2])
+		if n < 1 || n > maxLast {
+			return nil, http.StatusBadRequest, fmt.Sprintf("A quantidade de valores deve estar entre 1 e %d", maxLast)
+		}
+		return series[max(0, len(series)-n):], http.StatusOK, ""
+	}
+
+	start, end, err := window(r)
+	if err != nil {
+		return nil, http.StatusBadRequest, err.Error()
+	}
+	data := []source.Data{}
+	for _, entry := range series {
+		day, err := time.Parse(dateLayout, entry.Data)
+		if err != nil {
+			continue
+		}
+		if (!start.IsZero() && day.Before(start)) || (!end.IsZero() && day.After(end)) {
+			continue
+		}
+		data = append(data, entry)
+	}
+	return data, http.StatusOK, ""
+}
+
+// Parses dataInicial and dataFinal; either may be omitted
+func window(r *http.Request) (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+	if value := r.URL.Query().Get("dataInicial"); value != "" {
+		if start, err = time.Parse(dateLayout, value); err != nil {
+			return start, end, fmt.Errorf("dataInicial inválida: %s", value)
+		}
+	}
+	if value := r.URL.Query().Get("dataFinal"); value != "" {
+		if end, err = time.Parse(dateLayout, value); err != nil {
+			return start, end, fmt.Errorf("dataFinal inválida: %s", value)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return start, end, fmt.Errorf("dataFinal anterior a dataInicial")
+	}
+	return start, end, nil
+}
+
+// Error body in the shape the SGS API uses
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
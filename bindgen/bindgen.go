@@ -0,0 +1,294 @@
+package bindgen
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Compiler settings the pinned artifacts in build/ are produced with
+const (
+	SolcVersion   = "0.8.21"
+	OptimizerRuns = 200
+)
+
+var importPattern = regexp.MustCompile(`import\s+(?:\{[^}]*\}\s+from\s+)?"([^"]+)"`)
+
+// Compiled contract as stored in build/
+type Artifact struct {
+	Name    string
+	ABI     json.RawMessage
+	Bin     string            // creation bytecode, hex without 0x
+	Layout  json.RawMessage   // solc storage layout, for upgrade compatibility checks
+	Sources map[string]string // sha256 of each repository source, keyed by path from the repository root
+}
+
+// Paths of the pinned artifact files for a contract
+func ABIPath(buildDir, name string) string     { return filepath.Join(buildDir, name+".abi") }
+func BinPath(buildDir, name string) string     { return filepath.Join(buildDir, name+".bin") }
+func SourcesPath(buildDir, name string) string { return filepath.Join(buildDir, name+".sha256") }
+func LayoutPath(buildDir, name string) string  { return filepath.Join(buildDir, name+".layout.json") }
+
+// Reads the pinned artifacts of a contract
+func Load(buildDir, name string) (*Artifact, error) {
+	abiJSON, err := os.ReadFile(ABIPath(buildDir, name))
+	if err != nil {
+		return nil, err
+	}
+	bin, err := os.ReadFile(BinPath(buildDir, name))
+	if err != nil {
+		return nil, err
+	}
+	sources, err := readSources(SourcesPath(buildDir, name))
+	if err != nil {
+		return nil, err
+	}
+	layout, err := os.ReadFile(LayoutPath(buildDir, name))
+	if err != nil {
+		return nil, err
+	}
+	return &Artifact{Name: name, ABI: abiJSON, Bin: strings.TrimSpace(string(bin)), Layout: layout, Sources: sources}, nil
+}
+
+// Writes the artifacts in the layout checked into build/
+func (a *Artifact) Write(buildDir string) error {
+	var abiJSON bytes.Buffer
+	if err := json.Indent(&abiJSON, a.ABI, "  ", "  "); err != nil {
+		return fmt.Errorf("invalid ABI: %v", err)
+	}
+	if err := os.WriteFile(ABIPath(buildDir, a.Name), abiJSON.Bytes(), 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(BinPath(buildDir, a.Name), []byte(a.Bin), 0644); err != nil {
+		return err
+	}
+	var layout bytes.Buffer
+	if err := json.Indent(&layout, a.Layout, "", "  "); err != nil {
+		return fmt.Errorf("invalid storage layout: %v", err)
+	}
+	layout.WriteByte('\n')
+	if err := os.WriteFile(LayoutPath(buildDir, a.Name), layout.Bytes(), 0644); err != nil {
+		return err
+	}
+	return writeSources(SourcesPath(buildDir, a.Name), a.Sources)
+}
+
+// Go binding for the artifact under the given package and type name
+func (a *Artifact) Binding(pkg, typ string) ([]byte, error) {
+	code, err := bind.Bind([]string{typ}, []string{string(a.ABI)}, []string{a.Bin}, []map[string]string{nil}, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(code), nil
+}
+
+// Compares the recorded source hashes against the files under root
+func (a *Artifact) CheckSources(root string) error {
+	if len(a.Sources) == 0 {
+		return fmt.Errorf("%s: no source hashes recorded", a.Name)
+	}
+	for path, want := range a.Sources {
+		got, err := hashFile(filepath.Join(root, path))
+		if err != nil {
+			return err
+		}
+		if got != want {
+			return fmt.Errorf("%s changed since %s was compiled", path, a.Name)
+		}
+	}
+	return nil
+}
+
+// Compiles the contract in root/source with solc's standard JSON interface.
+// Imports are resolved against root first and then each include path,
+// e.g. node_modules for @openzeppelin/contracts.
+func Compile(ctx context.Context, solc, root, source string, includePaths []string) (*Artifact, error) {
+	if err := checkVersion(ctx, solc); err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string]string)
+	hashes := make(map[string]string)
+	if err := loadSource(root, includePaths, filepath.ToSlash(source), sources, hashes); err != nil {
+		return nil, err
+	}
+
+	input := map[string]any{
+		"language": "Solidity",
+		"sources":  contents(sources),
+		"settings": map[string]any{
+			"optimizer": map[string]any{"enabled": true, "runs": OptimizerRuns},
+			"outputSelection": map[string]any{
+				"*": map[string]any{"*": []string{"abi", "evm.bytecode.object", "storageLayout"}},
+			},
+		},
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, solc, "--standard-json")
+	cmd.Stdin = bytes.NewReader(body)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %v: %s", solc, err, stderr.String())
+	}
+
+	var output struct {
+		Errors []struct {
+			Severity         string `json:"severity"`
+			FormattedMessage string `json:"formattedMessage"`
+		} `json:"errors"`
+		Contracts map[string]map[string]struct {
+			ABI    json.RawMessage `json:"abi"`
+			Layout json.RawMessage `json:"storageLayout"`
+			EVM    struct {
+				Bytecode struct {
+					Object string `json:"object"`
+				} `json:"bytecode"`
+			} `json:"evm"`
+		} `json:"contracts"`
+	}
+	if err := json.Unmarshal(out, &output); err != nil {
+		return nil, fmt.Errorf("unreadable solc output: %v", err)
+	}
+	var errs []string
+	for _, e := range output.Errors {
+		if e.Severity == "error" {
+			errs = append(errs, e.FormattedMessage)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("compilation failed:\n%s", strings.Join(errs, "\n"))
+	}
+
+	name := strings.TrimSuffix(filepath.Base(source), ".sol")
+	contract, ok := output.Contracts[filepath.ToSlash(source)][name]
+	if !ok {
+		return nil, fmt.Errorf("contract %s not found in %s", name, source)
+	}
+	return &Artifact{Name: name, ABI: contract.ABI, Bin: contract.EVM.Bytecode.Object, Layout: contract.Layout, Sources: hashes}, nil
+}
+
+// Rejects compilers other than the pinned version, whose bytecode would differ
+func checkVersion(ctx context.Context, solc string) error {
+	out, err := exec.CommandContext(ctx, solc, "--version").Output()
+	if err != nil {
+		return fmt.Errorf("cannot run %s: %v", solc, err)
+	}
+	if !strings.Contains(string(out), "Version: "+SolcVersion+"+") {
+		return fmt.Errorf("%s is not solc %s:\n%s", solc, SolcVersion, out)
+	}
+	return nil
+}
+
+// Reads a source and its imports; only files under root are hashed
+func loadSource(root string, includePaths []string, path string, sources, hashes map[string]string) error {
+	if _, ok := sources[path]; ok {
+		return nil
+	}
+
+	full := filepath.Join(root, path)
+	inRoot := true
+	if _, err := os.Stat(full); err != nil {
+		inRoot = false
+		full = ""
+		for _, dir := range includePaths {
+			candidate := filepath.Join(dir, path)
+			if _, err := os.Stat(candidate); err == nil {
+				full = candidate
+				break
+			}
+		}
+		if full == "" {
+			return fmt.Errorf("import %s not found under %s or %v", path, root, includePaths)
+		}
+	}
+
+	content, err := os.ReadFile(full)
+	if err != nil {
+		return err
+	}
+	sources[path] = string(content)
+	if inRoot {
+		sum := sha256.Sum256(content)
+		hashes[path] = hex.EncodeToString(sum[:])
+	}
+
+	for _, match := range importPattern.FindAllStringSubmatch(string(content), -1) {
+		imported := match[1]
+		if strings.HasPrefix(imported, ".") {
+			imported = filepath.ToSlash(filepath.Join(filepath.Dir(path), imported))
+		}
+		if err := loadSource(root, includePaths, imported, sources, hashes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func contents(sources map[string]string) map[string]any {
+	result := make(map[string]any, len(sources))
+	for path, content := range sources {
+		result[path] = map[string]string{"content": content}
+	}
+	return result
+}
+
+func hashFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// sha256sum format: "<hash>  <path>" per line
+func readSources(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sources := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hash, source, ok := strings.Cut(scanner.Text(), "  ")
+		if !ok {
+			return nil, fmt.Errorf("%s: malformed line %q", path, scanner.Text())
+		}
+		sources[source] = hash
+	}
+	return sources, scanner.Err()
+}
+
+func writeSources(path string, sources map[string]string) error {
+	paths := make([]string, 0, len(sources))
+	for source := range sources {
+		paths = append(paths, source)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	for _, source := range paths {
+		fmt.Fprintf(&buf, "%s  %s\n", sources[source], source)
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
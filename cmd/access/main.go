@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strings"
+	"time"
+
+	"abi/access"
+	"abi/api"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract is managed")
+	mode := flag.String("mode", "", "switch read access to "+strings.Join(access.ModeNames, ", "))
+	grantPath := flag.String("grant", "", "CSV of consumers to grant read access to (address[,expiry])")
+	revokePath := flag.String("revoke", "", "CSV of consumers whose read access is revoked (expiries are ignored)")
+	batch := flag.Int("batch", 100, "accounts per transaction")
+	flag.Parse()
+
+	if *grantPath != "" && *revokePath != "" {
+		flag.Usage()
+		log.Fatal("use either -grant or -revoke")
+	}
+	var newMode uint8
+	var err error
+	if *mode != "" {
+		if newMode, err = access.ParseMode(*mode); err != nil {
+			log.Fatal(err)
+		}
+	}
+	var grants []access.Grant
+	switch {
+	case *grantPath != "":
+		grants, err = access.LoadCSV(*grantPath)
+	case *revokePath != "":
+		grants, err = access.LoadCSV(*revokePath)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	if *mode != "" || len(grants) > 0 {
+		auth, err := profile.Transactor()
+		if err != nil {
+			log.Fatal(err)
+		}
+		auth.Context = ctx
+
+		if *mode != "" {
+			setMode(ctx, client, auth, oracle, profile.Name, newMode)
+		}
+
+		switch {
+		case *grantPath != "":
+			pending, err := access.Pending(ctx, &oracle.OracleIndicatorCaller, grants)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Printf("[%s] %d of %d consumers need a grant", profile.Name, len(pending), len(grants))
+			done, err := access.Apply(ctx, client, auth, &oracle.OracleIndicatorTransactor, pending, *batch)
+			log.Printf("[%s] Granted read access to %d consumers", profile.Name, done)
+			if err != nil {
+				log.Fatal(err)
+			}
+		case *revokePath != "":
+			holders, err := access.Holders(ctx, &oracle.OracleIndicatorCaller, grants)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Printf("[%s] %d of %d consumers hold read access", profile.Name, len(holders), len(grants))
+			done, err := access.Revoke(ctx, client, auth, &oracle.OracleIndicatorTransactor, holders, *batch)
+			log.Printf("[%s] Revoked read access from %d consumers", profile.Name, done)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	current, err := oracle.AccessMode(opts)
+	if err != nil {
+		log.Fatalf("Failed to read access mode: %v", err)
+	}
+	log.Printf("[%s] Read access mode: %s", profile.Name, access.ModeName(current))
+}
+
+func setMode(ctx context.Context, client access.Backend, auth *bind.TransactOpts, oracle *api.OracleIndicator, name string, mode uint8) {
+	tx, err := oracle.SetAccessMode(auth, mode)
+	if err != nil {
+		log.Fatalf("Failed to set access mode: %v", api.DecodeError(err))
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		log.Fatalf("Transaction %s failed: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		log.Fatalf("Transaction %s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, client, tx, receipt))
+	}
+	log.Printf("[%s] Read access mode set to %s", name, access.ModeName(mode))
+}
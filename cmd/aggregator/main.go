@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"abi/api"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract the aggregator reads")
+	grant := flag.Bool("grant", true, "grant READ_ONLY to the aggregator (the signer must be admin)")
+	flag.Parse()
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Context = ctx
+
+	address, tx, _, err := api.DeployOracleIndicatorAggregator(auth, client, profile.ContractAddress())
+	if err != nil {
+		log.Fatalf("Failed to deploy: %v", api.DecodeError(err))
+	}
+	if _, err := bind.WaitDeployed(ctx, client, tx); err != nil {
+		log.Fatalf("Deployment %s failed: %v", tx.Hash().Hex(), err)
+	}
+	log.Printf("[%s] OracleIndicatorAggregator deployed at %s for %s", profile.Name, address.Hex(), profile.ContractAddress().Hex())
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	role, err := oracle.READONLY(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		log.Fatalf("Failed to read READ_ONLY: %v", err)
+	}
+	if !*grant {
+		log.Printf("[%s] Grant READ_ONLY (%x) to %s before using it", profile.Name, role, address.Hex())
+		return
+	}
+
+	tx, err = oracle.GrantRole(auth, role, address)
+	if err != nil {
+		log.Fatalf("Failed to grant READ_ONLY: %v", api.DecodeError(err))
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		log.Fatalf("Grant %s failed: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		log.Fatalf("Grant %s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, client, tx, receipt))
+	}
+	log.Printf("[%s] READ_ONLY granted to the aggregator; its answers are now public", profile.Name)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"abi/bindgen"
+)
+
+// Regenerates a contract binding from the pinned artifacts in build/.
+// With -solc it first recompiles the contract and refreshes those artifacts.
+// Run through go generate from the binding's package directory.
+func main() {
+	sol := flag.String("sol", "", "contract source, e.g. ../contract/OracleIndicator.sol")
+	buildDir := flag.String("build", "../build", "directory holding the pinned .abi, .bin and .sha256 artifacts")
+	solc := flag.String("solc", "", "solc "+bindgen.SolcVersion+" executable; when empty the pinned artifacts are used as they are")
+	include := flag.String("include", "node_modules", "comma separated directories searched for imports such as @openzeppelin/contracts, relative to the repository root")
+	pkg := flag.String("pkg", "", "Go package of the binding")
+	typ := flag.String("type", "", "Go type of the binding (default: contract name)")
+	out := flag.String("out", "", "binding file to write")
+	flag.Parse()
+
+	if *sol == "" || *pkg == "" || *out == "" {
+		flag.Usage()
+		log.Fatal("-sol, -pkg and -out are required")
+	}
+
+	name := strings.TrimSuffix(filepath.Base(*sol), ".sol")
+	if *typ == "" {
+		*typ = name
+	}
+
+	if *solc != "" {
+		// source paths end up in the bytecode metadata, so they are relative to the repository root
+		root := filepath.Dir(filepath.Clean(*buildDir))
+		source, err := filepath.Rel(root, *sol)
+		if err != nil {
+			log.Fatalf("Contract source must be under %s: %v", root, err)
+		}
+		var includePaths []string
+		for _, dir := range strings.Split(*include, ",") {
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(root, dir)
+			}
+			includePaths = append(includePaths, dir)
+		}
+
+		artifact, err := bindgen.Compile(context.Background(), *solc, root, source, includePaths)
+		if err != nil {
+			log.Fatalf("Failed to compile %s: %v", *sol, err)
+		}
+		if err := artifact.Write(*buildDir); err != nil {
+			log.Fatalf("Failed to write artifacts: %v", err)
+		}
+		log.Printf("Compiled %s with %s", source, *solc)
+	}
+
+	artifact, err := bindgen.Load(*buildDir, name)
+	if err != nil {
+		log.Fatalf("Failed to load artifacts: %v", err)
+	}
+	code, err := artifact.Binding(*pkg, *typ)
+	if err != nil {
+		log.Fatalf("Failed to generate binding: %v", err)
+	}
+	if err := os.WriteFile(*out, code, 0644); err != nil {
+		log.Fatalf("Failed to write binding: %v", err)
+	}
+}
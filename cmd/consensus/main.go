@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"abi/api"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract is configured")
+	quorum := flag.Int("quorum", -1, "agreeing reporter submissions that finalize a day (0 disables consensus)")
+	tolerance := flag.Int("tolerance-bps", 10, "maximum distance from the median, in basis points, for a submission to agree")
+	flag.Parse()
+
+	if *quorum < 0 || *quorum > 16 || *tolerance < 0 || *tolerance > 10000 {
+		flag.Usage()
+		log.Fatal("-quorum (0-16) is required and -tolerance-bps must be between 0 and 10000")
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Context = ctx
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx, err := oracle.SetConsensus(auth, uint8(*quorum), uint16(*tolerance))
+	if err != nil {
+		log.Fatalf("Failed to set consensus: %v", api.DecodeError(err))
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		log.Fatalf("Transaction %s failed: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		log.Fatalf("Transaction %s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, client, tx, receipt))
+	}
+	if *quorum == 0 {
+		log.Printf("[%s] Consensus disabled", profile.Name)
+		return
+	}
+	log.Printf("[%s] Days finalize once %d reporter submissions agree within %d bps", profile.Name, *quorum, *tolerance)
+}
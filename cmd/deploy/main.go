@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"abi/api"
+	"abi/network"
+	"abi/upgrade"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile to deploy on")
+	name := flag.String("name", "", "series name (default: the profile's series name)")
+	decimals := flag.Int("decimals", -1, "series decimals (default: the profile's series decimals)")
+	admin := flag.String("admin", "", "DEFAULT_ADMIN_ROLE holder (default: the signer)")
+	upgradeable := flag.Bool("upgradeable", true, "deploy OracleIndicatorV2 behind a proxy; false deploys the non-upgradeable OracleIndicator")
+	flag.Parse()
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	if *name == "" {
+		*name = profile.Series.Name
+	}
+	if *decimals < 0 {
+		*decimals = int(profile.Series.Decimals)
+	}
+	if *name == "" || *decimals > 255 {
+		log.Fatal("-name and -decimals (0-255) are required when the profile has no series")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Context = ctx
+	owner := auth.From
+	if *admin != "" {
+		owner = common.HexToAddress(*admin)
+	}
+
+	if !*upgradeable {
+		address, tx, _, err := api.DeployOracleIndicator(auth, client, *name, uint8(*decimals), owner)
+		if err != nil {
+			log.Fatalf("Failed to deploy: %v", api.DecodeError(err))
+		}
+		if _, err := bind.WaitDeployed(ctx, client, tx); err != nil {
+			log.Fatalf("Deployment %s failed: %v", tx.Hash().Hex(), err)
+		}
+		log.Printf("[%s] OracleIndicator deployed at %s", profile.Name, address.Hex())
+		return
+	}
+
+	deployment, err := upgrade.Deploy(ctx, auth, client, *name, uint8(*decimals), owner)
+	if err != nil {
+		log.Fatalf("Failed to deploy: %v", err)
+	}
+	log.Printf("[%s] OracleIndicatorV2 implementation deployed at %s", profile.Name, deployment.Implementation.Hex())
+	log.Printf("[%s] Proxy deployed at %s; set it as the profile's contract", profile.Name, deployment.Proxy.Hex())
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strings"
+	"time"
+
+	"abi/datekey"
+	"abi/emergency"
+	"abi/network"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract is corrected")
+	dates := flag.String("dates", "", "comma-separated days to retract (dd/mm/yyyy)")
+	restore := flag.Bool("restore", false, "clear the retraction of the days instead, keeping their stored values")
+	flag.Parse()
+
+	if *dates == "" {
+		flag.Usage()
+		log.Fatal("-dates is required")
+	}
+	var keys []datekey.Key
+	for _, value := range strings.Split(*dates, ",") {
+		key, err := datekey.Parse(strings.TrimSpace(value))
+		if err != nil {
+			log.Fatalf("Invalid date %q: %v", value, err)
+		}
+		keys = append(keys, key)
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *restore {
+		done, err := emergency.Restore(ctx, client, auth, profile.ContractAddress(), keys)
+		log.Printf("[%s] Restored %d of %d days", profile.Name, done, len(keys))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	done, err := emergency.Invalidate(ctx, client, auth, profile.ContractAddress(), keys)
+	log.Printf("[%s] Invalidated %d of %d days; republish them or rerun with -restore to restore", profile.Name, done, len(keys))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"math/big"
+	"time"
+
+	"abi/api"
+	"abi/network"
+	"abi/publisher"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract is configured")
+	minValue := flag.String("min", "", "smallest accepted value in contract units (0 with -max 0 disables the bounds)")
+	maxValue := flag.String("max", "", "largest accepted value in contract units")
+	deviation := flag.Int("max-deviation-bps", -1, "largest change from the last value, in basis points (0 disables the check)")
+	flag.Parse()
+
+	set := *minValue != "" || *maxValue != "" || *deviation >= 0
+	var lower, upper *big.Int
+	if set {
+		var ok bool
+		if lower, ok = new(big.Int).SetString(*minValue, 10); !ok {
+			log.Fatalf("Invalid -min %q", *minValue)
+		}
+		if upper, ok = new(big.Int).SetString(*maxValue, 10); !ok {
+			log.Fatalf("Invalid -max %q", *maxValue)
+		}
+		if lower.Cmp(upper) > 0 || *deviation < 0 || *deviation > 65535 {
+			flag.Usage()
+			log.Fatal("-min must not exceed -max and -max-deviation-bps (0-65535) is required")
+		}
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if set {
+		auth, err := profile.Transactor()
+		if err != nil {
+			log.Fatal(err)
+		}
+		auth.Context = ctx
+		tx, err := oracle.SetLimits(auth, lower, upper, uint16(*deviation))
+		if err != nil {
+			log.Fatalf("Failed to set limits: %v", api.DecodeError(err))
+		}
+		receipt, err := bind.WaitMined(ctx, client, tx)
+		if err != nil {
+			log.Fatalf("Transaction %s failed: %v", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			log.Fatalf("Transaction %s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, client, tx, receipt))
+		}
+	}
+
+	limits, base, err := publisher.ReadLimits(&bind.CallOpts{Context: ctx}, &oracle.OracleIndicatorCaller)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if limits.Min.Sign() == 0 && limits.Max.Sign() == 0 {
+		log.Printf("[%s] Bounds disabled", profile.Name)
+	} else {
+		log.Printf("[%s] Values must lie in [%s, %s]", profile.Name, limits.Min, limits.Max)
+	}
+	switch {
+	case limits.MaxDeviationBps == 0:
+		log.Printf("[%s] Deviation check disabled", profile.Name)
+	case base == nil:
+		log.Printf("[%s] Values may move %d bps; the next value is not checked (no usable last value)", profile.Name, limits.MaxDeviationBps)
+	default:
+		log.Printf("[%s] Values may move %d bps from the last value %s", profile.Name, limits.MaxDeviationBps, base)
+	}
+}
@@ -2,143 +2,207 @@ package main
 
 import (
 	"context"
-	"encoding/csv"
+	"flag"
 	"fmt"
-	"log"
-	"math/big"
+	"log/slog"
 	"os"
+	"strconv"
 	"time"
 
-	"abi/api"
 	"abi/json"
+	"abi/logging"
+	"abi/metrics"
+	"abi/network"
+	"abi/publisher"
+	"abi/reporter"
+	"abi/validate"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
 )
 
-func main() {
+// Series label used in metrics
+const seriesName = "sgs.12"
 
-	err := godotenv.Load()
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "comma separated profile names to publish to (default: all)")
+	sourceURL := flag.String("source", "https://api.bcb.gov.br/dados/serie/bcdata.sgs.12/dados?formato=json", "BCB SGS endpoint to fetch the series from")
+	cacheDir := flag.String("cache", ".cache/bcb", "directory for cached BCB responses")
+	offline := flag.Bool("offline", false, "replay the cached BCB response instead of downloading it")
+	var rules validate.Rules
+	flag.Func("min-value", "reject values below this bound", floatFlag(&rules.MinValue))
+	flag.Func("max-value", "reject values above this bound", floatFlag(&rules.MaxValue))
+	flag.Float64Var(&rules.MaxJump, "max-jump", 0, "reject relative changes from the previous value above this fraction (0 disables)")
+	maxErrorRate := flag.Float64("max-error-rate", 0.05, "abort when more than this fraction of entries is rejected")
+	reportPath := flag.String("validation-report", "", "write the validation report as JSON to this file")
+	updatedAtMode := flag.String("updatedat", publisher.UpdatedAtSource, "value for _updatedat: source (when the entry was first published, as the cache remembers), block (latest block timestamp) or now")
+	metricsAddr := flag.String("metrics", "", "serve Prometheus metrics on this address, e.g. :9100 (disabled when empty)")
+	metricsHold := flag.Duration("metrics-hold", 0, "keep serving metrics for this long after publishing finishes")
+	logFormat := flag.String("log-format", "text", "log output format: text or json")
+	logLevel := flag.String("log-level", "info", "minimum log level: debug, info, warn or error")
+	skipPreflight := flag.Bool("skip-preflight", false, "publish without checking chain, contract, role, series and balance first")
+	consensus := flag.Bool("consensus", false, "submit each day as this signer's reporter vote (submitValue) instead of saving it directly")
+	signReports := flag.String("sign-reports", "", "instead of publishing, write EIP-712 reports signed with each profile's reporter key to this file for a relayer")
+	reportValidity := flag.Duration("report-validity", 24*time.Hour, "how long signed reports can be submitted after signing")
+	flag.Parse()
+
+	logger, err := logging.New(os.Stderr, *logFormat, *logLevel)
 	if err != nil {
-		log.Fatal("Error loading .env file")
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
+	logger = logger.With("run_id", logging.NewRunID(), "series", seriesName)
 
-	client, err := ethclient.Dial("http://127.0.0.1:8545")
-	if err != nil {
-		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+	switch *updatedAtMode {
+	case publisher.UpdatedAtSource, publisher.UpdatedAtBlock, publisher.UpdatedAtNow:
+	default:
+		fatal(logger, "Invalid -updatedat", "value", *updatedAtMode)
 	}
 
-	contractAddress := common.HexToAddress("<SMART_CONTRACT>")
-
-	oracle, err := api.NewApi(contractAddress, client)
+	err = godotenv.Load()
 	if err != nil {
-		log.Fatalf("Error initializing contract: %v", err)
+		fatal(logger, "Error loading .env file", "err", err)
 	}
 
-	privateKey := os.Getenv("PRIVATE_KEY")
-	chainID := big.NewInt(31337)
-	auth, err := createAuth(privateKey, chainID)
+	profiles, err := network.Load(*networksPath)
 	if err != nil {
-		log.Fatalf("Failed to create authorized transactor: %v", err)
+		fatal(logger, "Failed to load network profiles", "err", err)
 	}
-
-	url := "https://api.bcb.gov.br/dados/serie/bcdata.sgs.12/dados?formato=json"
-	data, err := json.FetchData(url)
+	profiles, err = network.Select(profiles, *only)
 	if err != nil {
-		log.Fatalf("Failed to fetch data from API: %v", err)
+		fatal(logger, "Failed to select network profiles", "err", err)
 	}
 
-	for _, entry := range data {
+	if *metricsAddr != "" {
+		metrics.Serve(*metricsAddr)
+	}
 
-		layout := "02/01/2006"
-		date, err := time.Parse(layout, entry.Data)
-		if err != nil {
-			log.Printf("Failed to parse date %s: %v", entry.Data, err)
-			continue
+	url := *sourceURL
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	fetcher := json.NewFetcher()
+	fetcher.Cache = json.NewCache(*cacheDir)
+	fetcher.Offline = *offline
+	fetcher.Logger = logger.With("stage", "fetch")
+	fetchStart := time.Now()
+	series, err := fetcher.FetchSeries(ctx, url)
+	cancel()
+	metrics.FetchDuration.Observe(time.Since(fetchStart).Seconds())
+	fetchLog := logger.With("stage", "fetch", "url", url, "duration", time.Since(fetchStart))
+	if err != nil {
+		metrics.FetchErrors.WithLabelValues(metrics.FetchErrorKind(err)).Inc()
+		fatal(fetchLog, "Failed to fetch data from API", "err", err)
+	}
+	fetchLog.Info("Fetched series", "entries", len(series.Data),
+		"published_at", publisher.FormatTime(series.PublishedAt), "retrieved_at", publisher.FormatTime(series.RetrievedAt))
+
+	accepted, report := validate.Validate(series.Data, rules)
+	metrics.ObservationsParsed.Add(float64(report.Accepted))
+	metrics.ObservationsRejected.Add(float64(len(report.Rejected)))
+	validateLog := logger.With("stage", "validate")
+	for _, issue := range report.Rejected {
+		validateLog.Warn("Rejected observation", "date", issue.Date, "value", issue.Value, "reason", issue.Reason)
+	}
+	validateLog.Info("Validated series", "total", report.Total, "accepted", report.Accepted,
+		"rejected", len(report.Rejected), "normalized", report.Normalized)
+	if *reportPath != "" {
+		if err := writeReport(*reportPath, report); err != nil {
+			validateLog.Error("Failed to write validation report", "path", *reportPath, "err", err)
 		}
+	}
+	if err := report.Check(*maxErrorRate); err != nil {
+		fatal(validateLog, "Validation failed", "err", err)
+	}
 
-		timestamp := big.NewInt(date.Unix())
-
-		value, ok := new(big.Float).SetString(entry.Valor)
-		if !ok {
-			log.Printf("Invalid value format for %s", entry.Valor)
-			continue
+	observations := publisher.Scale(logger.With("stage", "scale"), accepted)
+
+	if *signReports != "" {
+		signLog := logger.With("stage", "sign")
+		var reports []publisher.SignedReport
+		for _, profile := range profiles {
+			key, err := profile.ReporterKey()
+			if err != nil {
+				fatal(signLog, "Failed to load reporter key", "network", profile.Name, "err", err)
+			}
+			signed, err := publisher.SignReports(key, profile, observations, *updatedAtMode, *reportValidity)
+			if err != nil {
+				fatal(signLog, "Failed to sign reports", "network", profile.Name, "err", err)
+			}
+			reports = append(reports, signed...)
 		}
-
-		scale := new(big.Float).SetFloat64(1e6)
-		value.Mul(value, scale)
-
-		intValue := new(big.Int)
-		value.Int(intValue)
-
-		updatedAt := big.NewInt(time.Now().Unix())
-
-		fmt.Printf("Timestamp for date %s: %d\n", entry.Data, timestamp.Int64())
-		fmt.Printf("Value for date %s: %s\n", entry.Data, value.String())
-
-		tx, err := oracle.SaveIndicator(auth, timestamp, intValue, updatedAt, 0)
-		if err != nil {
-			log.Printf("Failed to save indicator for date %s: %v", entry.Data, err)
-			continue
+		if err := publisher.WriteReports(*signReports, reports); err != nil {
+			fatal(signLog, "Failed to write reports", "path", *signReports, "err", err)
 		}
+		signLog.Info("Signed reports written", "path", *signReports, "reports", len(reports))
+		return
+	}
 
-		receipt, err := waitForReceipt(client, tx.Hash())
-		if err != nil {
-			log.Printf("Failed to get transaction receipt for date %s: %v", entry.Data, err)
+	opts := publisher.Options{Series: seriesName, UpdatedAt: *updatedAtMode, SkipPreflight: *skipPreflight}
+	var statuses []publisher.Status
+	for _, profile := range profiles {
+		statuses = append(statuses, publish(logger.With("network", profile.Name), profile, observations, opts, *consensus))
+	}
+
+	failed := false
+	for _, status := range statuses {
+		if status.Err != nil {
+			failed = true
+			logger.Error("Publishing aborted", "network", status.Network, "err", status.Err)
 			continue
 		}
-
-		err = saveCSV(timestamp.String(), receipt.GasUsed)
-		if err != nil {
-			log.Printf("Failed to save transaction details to CSV: %v", err)
+		if status.Failed > 0 {
+			failed = true
 		}
-
-		fmt.Printf("Transaction sent for date %s: %s\n", entry.Data, tx.Hash().Hex())
-		fmt.Printf("Transaction receipt for date %s: %+v\n", entry.Data, receipt)
-		fmt.Printf("Gas used for date %s: %d\n", entry.Data, receipt.GasUsed)
+		logger.Info("Publishing finished", "network", status.Network, "sent", status.Sent, "failed", status.Failed)
 	}
-}
-
-func waitForReceipt(client *ethclient.Client, txHash common.Hash) (*types.Receipt, error) {
-	for {
-		receipt, err := client.TransactionReceipt(context.Background(), txHash)
-		if err == nil {
-			return receipt, nil
-		}
-		time.Sleep(time.Second)
+	if *metricsAddr != "" && *metricsHold > 0 {
+		time.Sleep(*metricsHold)
+	}
+	if failed {
+		os.Exit(1)
 	}
 }
 
-func createAuth(privateKey string, chainID *big.Int) (*bind.TransactOpts, error) {
-	key, err := crypto.HexToECDSA(privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load private key: %v", err)
-	}
-	auth, err := bind.NewKeyedTransactorWithChainID(key, chainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authorized transactor: %v", err)
+func floatFlag(target **float64) func(string) error {
+	return func(value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*target = &f
+		return nil
 	}
-	return auth, nil
 }
 
-func saveCSV(timestamp string, gasUsed uint64) error {
-	file, err := os.OpenFile("gasreport.csv", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+func writeReport(path string, report *validate.Report) error {
+	file, err := os.Create(path)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+	return report.WriteJSON(file)
+}
 
-	err = writer.Write([]string{timestamp, fmt.Sprintf("%d", gasUsed)})
+// Connects to the profile's endpoints and publishes the observations there,
+// or votes for them when the contract finalizes days by consensus
+func publish(logger *slog.Logger, profile network.Profile, observations []publisher.Observation, opts publisher.Options, consensus bool) publisher.Status {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
 	if err != nil {
-		return err
+		return publisher.Status{Network: profile.Name, Err: err}
 	}
+	defer client.Close()
+	go client.Monitor(ctx, 30*time.Second)
+
+	if consensus {
+		return reporter.Submit(ctx, logger, client, profile, observations, opts.UpdatedAt)
+	}
+	return publisher.Publish(ctx, logger, client, profile, observations, opts)
+}
 
-	return nil
+func fatal(logger *slog.Logger, msg string, args ...any) {
+	logger.Error(msg, args...)
+	os.Exit(1)
 }
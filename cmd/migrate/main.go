@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"abi/datekey"
+	"abi/migrate"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract receives the data")
+	from := flag.String("from", "", "address of the old deployment to copy from")
+	start := flag.String("start", "", "first day scanned on the old deployment (dd/mm/yyyy)")
+	end := flag.String("end", "", "last day scanned (dd/mm/yyyy, default: today)")
+	rescale := flag.Bool("rescale", false, "convert values when the decimals differ")
+	batch := flag.Int("batch", 20, "transactions sent before waiting for receipts")
+	reportPath := flag.String("report", "", "also write the verification report as JSON to this file")
+	dryRun := flag.Bool("dry-run", false, "only report what would be written, including retracted and out-of-order days")
+	flag.Parse()
+
+	if !common.IsHexAddress(*from) || *start == "" {
+		flag.Usage()
+		log.Fatal("-from and -start are required")
+	}
+	first, err := datekey.Parse(*start)
+	if err != nil {
+		log.Fatalf("Invalid -start: %v", err)
+	}
+	last := datekey.Today()
+	if *end != "" {
+		if last, err = datekey.Parse(*end); err != nil {
+			log.Fatalf("Invalid -end: %v", err)
+		}
+	}
+	if last < first {
+		log.Fatal("-end is before -start")
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report := migrate.Run(ctx, client, auth, common.HexToAddress(*from), profile.ContractAddress(), migrate.Options{
+		Start:     first,
+		End:       last,
+		Rescale:   *rescale,
+		BatchSize: *batch,
+		DryRun:    *dryRun,
+	})
+	report.Write(os.Stdout)
+
+	if *reportPath != "" {
+		file, err := os.Create(*reportPath)
+		if err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+		if err := report.WriteJSON(file); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+		file.Close()
+	}
+
+	if err := report.Check(); err != nil {
+		log.Fatalf("[%s] %v", profile.Name, err)
+	}
+	if report.DryRun {
+		return
+	}
+	log.Printf("[%s] Migrated %d days from %s to %s", profile.Name, report.Verified, *from, profile.ContractAddress().Hex())
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"time"
+
+	"abi/alert"
+	"abi/api"
+	"abi/datekey"
+	"abi/failover"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Names reported to the webhook
+const (
+	checkRPC       = "rpc"
+	checkStaleness = "staleness"
+	checkLatestDay = "latest-day"
+	checkBalance   = "balance"
+)
+
+type settings struct {
+	from       common.Address
+	publisher  common.Address
+	minBalance *big.Int
+	maxAge     time.Duration
+	cutoffHour int
+}
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "comma separated profile names to monitor (default: all)")
+	from := flag.String("from", "", "caller address holding the READ_ONLY role (default: the zero address, enough when access is public)")
+	publisher := flag.String("publisher", "", "publisher account whose balance is checked (optional)")
+	minBalance := flag.Float64("min-balance", 0.1, "minimum publisher balance in ether")
+	maxAge := flag.Duration("max-age", 36*time.Hour, "maximum age of the last value's updatedat")
+	cutoffHour := flag.Int("cutoff-hour", 12, "hour (Sao Paulo) after which the previous business day must be on-chain")
+	interval := flag.Duration("interval", 15*time.Minute, "time between checks")
+	once := flag.Bool("once", false, "run the checks once and exit non-zero if any fails")
+	webhookURL := flag.String("webhook", "", "URL that receives alerts as JSON (optional)")
+	flag.Parse()
+
+	if *from != "" && !common.IsHexAddress(*from) {
+		flag.Usage()
+		log.Fatalf("-from %q is not an address", *from)
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil {
+		log.Fatalf("Failed to select network profiles: %v", err)
+	}
+
+	cfg := settings{
+		from:       common.HexToAddress(*from),
+		maxAge:     *maxAge,
+		cutoffHour: *cutoffHour,
+	}
+	if *publisher != "" {
+		cfg.publisher = common.HexToAddress(*publisher)
+		cfg.minBalance, _ = new(big.Float).Mul(big.NewFloat(*minBalance), big.NewFloat(params.Ether)).Int(nil)
+	}
+
+	var hook *alert.Webhook
+	if *webhookURL != "" {
+		hook = alert.NewWebhook(*webhookURL)
+	}
+
+	for {
+		healthy := true
+		for _, profile := range profiles {
+			for check, failure := range run(profile, cfg) {
+				if failure != nil {
+					healthy = false
+					log.Printf("[%s] %s: %v", profile.Name, check, failure)
+				}
+				if hook != nil {
+					if err := hook.Report(context.Background(), profile.Name, check, failure); err != nil {
+						log.Printf("[%s] Failed to send alert: %v", profile.Name, err)
+					}
+				}
+			}
+		}
+
+		if *once {
+			if !healthy {
+				os.Exit(1)
+			}
+			return
+		}
+		time.Sleep(*interval)
+	}
+}
+
+// Runs every check against one network and returns each one's failure (nil when passing)
+func run(profile network.Profile, cfg settings) map[string]error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	results := make(map[string]error)
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		results[checkRPC] = err
+		return results
+	}
+	defer client.Close()
+
+	oracle, err := api.NewOracleIndicatorCaller(profile.ContractAddress(), client)
+	if err != nil {
+		results[checkRPC] = err
+		return results
+	}
+	results[checkRPC] = nil
+
+	opts := &bind.CallOpts{From: cfg.from, Context: ctx}
+	results[checkStaleness] = checkLast(oracle, opts, cfg.maxAge)
+	results[checkLatestDay] = checkExpectedDay(client, profile.ContractAddress(), opts, cfg.cutoffHour)
+	if cfg.minBalance != nil {
+		results[checkBalance] = checkPublisherBalance(ctx, client, cfg.publisher, cfg.minBalance)
+	}
+
+	return results
+}
+
+func checkLast(oracle *api.OracleIndicatorCaller, opts *bind.CallOpts, maxAge time.Duration) error {
+	feed, err := oracle.GetLast(opts)
+	if err != nil {
+		return fmt.Errorf("failed to read last value: %v", api.DecodeError(err))
+	}
+	if feed.Updatedat.Sign() == 0 {
+		return fmt.Errorf("no value has been published")
+	}
+
+	updatedAt := time.Unix(feed.Updatedat.Int64(), 0)
+	if age := time.Since(updatedAt); age > maxAge {
+		return fmt.Errorf("last value updated at %s, %s ago (limit %s)", updatedAt.UTC().Format(time.RFC3339), age.Truncate(time.Second), maxAge)
+	}
+	return nil
+}
+
+// The BCB publishes a business day's value on the next business day, so once the
+// cutoff hour has passed the previous business day must already be stored
+func checkExpectedDay(backend bind.ContractCaller, address common.Address, opts *bind.CallOpts, cutoffHour int) error {
+	now := time.Now().In(datekey.Location)
+	expected := datekey.FromTime(now).PreviousBusinessDay()
+	if now.Hour() < cutoffHour {
+		expected = expected.PreviousBusinessDay()
+	}
+
+	entry, _, err := api.ReadDay(opts, backend, address, expected.Timestamp())
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", expected, err)
+	}
+	if entry.Updatedat.Sign() == 0 {
+		return fmt.Errorf("no value stored for business day %s (last stored: %s)", expected, lastStoredDay(backend, address, opts, expected))
+	}
+	return nil
+}
+
+// Walks back from a day to the most recent one with a stored value, giving up after a month
+func lastStoredDay(backend bind.ContractCaller, address common.Address, opts *bind.CallOpts, from datekey.Key) string {
+	for day := from - 1; day > from-31; day-- {
+		entry, _, err := api.ReadDay(opts, backend, address, day.Timestamp())
+		if err != nil {
+			return "unknown"
+		}
+		if entry.Updatedat.Sign() != 0 {
+			return day.String()
+		}
+	}
+	return "none in the last 30 days"
+}
+
+func checkPublisherBalance(ctx context.Context, client *failover.Client, publisher common.Address, minimum *big.Int) error {
+	balance, err := client.BalanceAt(ctx, publisher, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read balance of %s: %v", publisher.Hex(), err)
+	}
+	if balance.Cmp(minimum) < 0 {
+		return fmt.Errorf("publisher %s balance %s wei below minimum %s wei", publisher.Hex(), balance, minimum)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"math/big"
+	"time"
+
+	"abi/api"
+	"abi/datekey"
+	"abi/network"
+	"abi/publisher"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract receives the value")
+	date := flag.String("date", "", "day to write (dd/mm/yyyy)")
+	value := flag.String("value", "", "value in contract units, as saveIndicator would store it")
+	updatedAt := flag.String("updatedat", "", "publication time (RFC 3339, default: now)")
+	confidence := flag.Uint("confidence", 100, "confidence stored with the value")
+	flag.Parse()
+
+	if *date == "" || *value == "" || *confidence > 255 {
+		flag.Usage()
+		log.Fatal("-date and -value are required and -confidence must fit in 8 bits")
+	}
+	key, err := datekey.Parse(*date)
+	if err != nil {
+		log.Fatalf("Invalid -date: %v", err)
+	}
+	amount, ok := new(big.Int).SetString(*value, 10)
+	if !ok {
+		log.Fatalf("Invalid -value %q", *value)
+	}
+	published := time.Now()
+	if *updatedAt != "" {
+		if published, err = time.Parse(time.RFC3339, *updatedAt); err != nil {
+			log.Fatalf("Invalid -updatedat: %v", err)
+		}
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Context = ctx
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	limits, base, err := publisher.ReadLimits(&bind.CallOpts{Context: ctx}, &oracle.OracleIndicatorCaller)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := limits.Check(amount, base); err != nil {
+		log.Printf("[%s] Overriding %v", profile.Name, err)
+	} else {
+		log.Printf("[%s] %s is within the limits; saveIndicator would accept it too", profile.Name, amount)
+	}
+
+	tx, err := oracle.OverrideIndicator(auth, key.Timestamp(), amount, big.NewInt(published.Unix()), uint8(*confidence))
+	if err != nil {
+		log.Fatalf("Failed to override: %v", api.DecodeError(err))
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		log.Fatalf("Transaction %s failed: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		log.Fatalf("Transaction %s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, client, tx, receipt))
+	}
+	log.Printf("[%s] Stored %s for %s in block %d", profile.Name, amount, key, receipt.BlockNumber.Uint64())
+}
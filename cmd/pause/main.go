@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"abi/emergency"
+	"abi/network"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract is paused")
+	flag.Parse()
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	receipt, err := emergency.Pause(ctx, client, auth, profile.ContractAddress())
+	if err != nil {
+		log.Fatalf("Failed to pause: %v", err)
+	}
+	if receipt == nil {
+		log.Printf("[%s] Already paused", profile.Name)
+		return
+	}
+	log.Printf("[%s] Reads paused in block %d (%s)", profile.Name, receipt.BlockNumber.Uint64(), receipt.TxHash.Hex())
+}
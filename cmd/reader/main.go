@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"time"
+
+	"abi/api"
+	"abi/datekey"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Fixed-point scale used by the contract for interval products
+const precision = 1e8
+
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "Ethereum RPC endpoint")
+	contract := flag.String("contract", "", "OracleIndicator contract address")
+	from := flag.String("from", "", "caller address holding the READ_ONLY role (default: the zero address, enough when access is public)")
+	start := flag.String("start", "", "first day of the interval (dd/mm/yyyy)")
+	end := flag.String("end", "", "last day of the interval (dd/mm/yyyy)")
+	legacy := flag.Bool("legacy", false, "use the unbounded getInterval loop (contracts without checkpoints)")
+	last := flag.Bool("last", false, "report the last stored value and its staleness")
+	maxAge := flag.Duration("max-age", 36*time.Hour, "age of the last value's updatedat after which it is reported stale")
+	flag.Parse()
+
+	interval := *start != "" || *end != ""
+	if *contract == "" || (!*last && !interval) || (interval && (*start == "" || *end == "")) {
+		flag.Usage()
+		log.Fatal("-contract and either -last or both -start and -end are required")
+	}
+	if *from != "" && !common.IsHexAddress(*from) {
+		log.Fatalf("-from %q is not an address", *from)
+	}
+
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+	}
+
+	oracle, err := api.NewOracleIndicatorCaller(common.HexToAddress(*contract), client)
+	if err != nil {
+		log.Fatalf("Error initializing contract: %v", err)
+	}
+
+	opts := &bind.CallOpts{From: common.HexToAddress(*from)}
+
+	stale := false
+	if *last {
+		stale = printLast(oracle, opts, *maxAge)
+	}
+	if interval {
+		printInterval(oracle, opts, *start, *end, *legacy)
+	}
+	if stale {
+		os.Exit(1)
+	}
+}
+
+// Prints the last stored value and reports whether its updatedat is older than maxAge
+func printLast(oracle *api.OracleIndicatorCaller, opts *bind.CallOpts, maxAge time.Duration) bool {
+	feed, err := oracle.GetLast(opts)
+	if err != nil {
+		log.Fatalf("Failed to read last value: %v", err)
+	}
+
+	updatedAt := time.Unix(feed.Updatedat.Int64(), 0).UTC()
+	age := time.Since(updatedAt).Truncate(time.Second)
+	stale := age > maxAge
+
+	fmt.Printf("Last value: %s (decimals %d, confidence %d)\n", feed.Value.String(), feed.Decimal, feed.Confidence)
+	fmt.Printf("Updated at: %s (%s, age %s)\n", updatedAt.Format(time.RFC3339), datekey.FromTime(updatedAt), age)
+	if stale {
+		fmt.Printf("STALE: older than %s\n", maxAge)
+	}
+	return stale
+}
+
+func printInterval(oracle *api.OracleIndicatorCaller, opts *bind.CallOpts, start, end string, legacy bool) {
+	startKey, err := datekey.Parse(start)
+	if err != nil {
+		log.Fatalf("Failed to parse start date %s: %v", start, err)
+	}
+	endKey, err := datekey.Parse(end)
+	if err != nil {
+		log.Fatalf("Failed to parse end date %s: %v", end, err)
+	}
+
+	var product *big.Int
+	if legacy {
+		product, err = oracle.GetInterval(opts, startKey.Timestamp(), endKey.Timestamp())
+	} else {
+		product, err = oracle.GetCumulativeInterval(opts, startKey.Timestamp(), endKey.Timestamp())
+	}
+	if err != nil {
+		log.Fatalf("Failed to read interval %s - %s: %v", start, end, err)
+	}
+
+	factor := new(big.Float).Quo(new(big.Float).SetInt(product), big.NewFloat(precision))
+	fmt.Printf("Interval %s - %s: %s (factor %s)\n", start, end, product.String(), factor.Text('f', 8))
+}
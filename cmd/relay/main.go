@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"abi/logging"
+	"abi/network"
+	"abi/publisher"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "comma separated profile names to relay to (default: all)")
+	reportsPath := flag.String("reports", "", "signed reports written by the publisher's -sign-reports")
+	logFormat := flag.String("log-format", "text", "log output format: text or json")
+	logLevel := flag.String("log-level", "info", "minimum log level: debug, info, warn or error")
+	flag.Parse()
+
+	logger, err := logging.New(os.Stderr, *logFormat, *logLevel)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	logger = logger.With("run_id", logging.NewRunID())
+
+	if *reportsPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	reports, err := publisher.LoadReports(*reportsPath)
+	if err != nil {
+		logger.Error("Failed to load reports", "err", err)
+		os.Exit(1)
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err == nil {
+		profiles, err = network.Select(profiles, *only)
+	}
+	if err != nil {
+		logger.Error("Failed to load network profiles", "err", err)
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, profile := range profiles {
+		netLog := logger.With("network", profile.Name)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		client, err := profile.Connect(ctx)
+		if err != nil {
+			cancel()
+			netLog.Error("Failed to connect", "err", err)
+			failed = true
+			continue
+		}
+		status := publisher.Relay(ctx, netLog, client, profile, reports)
+		client.Close()
+		cancel()
+
+		if status.Err != nil {
+			netLog.Error("Relaying aborted", "err", status.Err)
+			failed = true
+			continue
+		}
+		if status.Failed > 0 {
+			failed = true
+		}
+		netLog.Info("Relaying finished", "sent", status.Sent, "failed", status.Failed)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
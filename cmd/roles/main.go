@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"abi/api"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose contract is managed")
+	account := flag.String("account", "", "account to inspect, grant to or revoke from (default: the signer)")
+	grant := flag.String("grant", "", "role to grant to -account, e.g. PUBLISHER_ROLE")
+	revoke := flag.String("revoke", "", "role to revoke from -account")
+	renounce := flag.String("renounce", "", "role the signer gives up, e.g. PUBLISHER_ROLE once a hot key holds it")
+	flag.Parse()
+
+	actions := 0
+	for _, value := range []string{*grant, *revoke, *renounce} {
+		if value != "" {
+			actions++
+		}
+	}
+	if actions > 1 {
+		flag.Usage()
+		log.Fatal("use at most one of -grant, -revoke and -renounce")
+	}
+	if *account != "" && !common.IsHexAddress(*account) {
+		log.Fatalf("Invalid -account %q", *account)
+	}
+
+	profiles, err := network.Load(*networksPath)
+	if err != nil {
+		log.Fatalf("Failed to load network profiles: %v", err)
+	}
+	profiles, err = network.Select(profiles, *only)
+	if err != nil || len(profiles) != 1 {
+		flag.Usage()
+		log.Fatalf("-network must name exactly one profile: %v", err)
+	}
+	profile := profiles[0]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Context = ctx
+	target := auth.From
+	if *account != "" {
+		target = common.HexToAddress(*account)
+	}
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var tx *types.Transaction
+	switch {
+	case *grant != "":
+		role := roleID(*grant)
+		tx, err = oracle.GrantRole(auth, role, target)
+		log.Printf("[%s] Granting %s to %s", profile.Name, *grant, target.Hex())
+	case *revoke != "":
+		role := roleID(*revoke)
+		tx, err = oracle.RevokeRole(auth, role, target)
+		log.Printf("[%s] Revoking %s from %s", profile.Name, *revoke, target.Hex())
+	case *renounce != "":
+		role := roleID(*renounce)
+		target = auth.From
+		tx, err = oracle.RenounceRole(auth, role, auth.From)
+		log.Printf("[%s] Renouncing %s for %s", profile.Name, *renounce, auth.From.Hex())
+	}
+	if err != nil {
+		log.Fatalf("Transaction failed: %v", api.DecodeError(err))
+	}
+	if tx != nil {
+		receipt, err := bind.WaitMined(ctx, client, tx)
+		if err != nil {
+			log.Fatalf("Transaction %s failed: %v", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			log.Fatalf("Transaction %s reverted: %v", tx.Hash().Hex(), api.ReplayRevert(ctx, client, tx, receipt))
+		}
+	}
+
+	printRoles(ctx, client, profile, oracle, target)
+}
+
+func roleID(name string) common.Hash {
+	role, err := api.RoleID(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return role
+}
+
+// Lists which known roles the account holds and which role publishing requires
+func printRoles(ctx context.Context, caller bind.ContractCaller, profile network.Profile, oracle *api.OracleIndicator, account common.Address) {
+	opts := &bind.CallOpts{Context: ctx}
+	var names []string
+	ids := make(map[string]common.Hash)
+	for id, name := range api.RoleNames {
+		names = append(names, name)
+		ids[name] = id
+	}
+	sort.Strings(names)
+
+	fmt.Printf("%s on %s (%s)\n", account.Hex(), profile.Name, profile.ContractAddress().Hex())
+	for _, name := range names {
+		has, err := oracle.HasRole(opts, ids[name], account)
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", name, err)
+		}
+		mark := " "
+		if has {
+			mark = "x"
+		}
+		fmt.Printf("  [%s] %s\n", mark, name)
+	}
+
+	publisher, err := api.PublisherRole(opts, caller, profile.ContractAddress())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("saveIndicator requires %s\n", api.RoleNames[publisher])
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"abi/api"
+	"abi/bindgen"
+	"abi/network"
+	"abi/upgrade"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func main() {
+	networksPath := flag.String("networks", "networks.json", "network profiles file")
+	only := flag.String("network", "", "profile whose proxy is upgraded")
+	buildDir := flag.String("build", "build", "directory holding the pinned artifacts and storage layouts")
+	from := flag.String("from", "", "contract currently behind the proxy (default: detected from the live version)")
+	to := flag.String("to", "", "contract to upgrade to, e.g. OracleIndicatorV2")
+	check := flag.Bool("check", false, "only check storage layout compatibility")
+	call := flag.String("call", "", "argumentless function of the new implementation to run in the upgrade transaction (default: initializeV2 when leaving OracleIndicatorV1)")
+	batch := flag.Int64("batch", 200, "V1 checkpoints converted per transaction after upgrading to OracleIndicatorV2")
+	flag.Parse()
+
+	if *to == "" {
+		flag.Usage()
+		log.Fatal("-to is required")
+	}
+
+	var profile network.Profile
+	if *from == "" || !*check {
+		profiles, err := network.Load(*networksPath)
+		if err != nil {
+			log.Fatalf("Failed to load network profiles: %v", err)
+		}
+		profiles, err = network.Select(profiles, *only)
+		if err != nil || len(profiles) != 1 {
+			flag.Usage()
+			log.Fatalf("-network must name exactly one profile: %v", err)
+		}
+		profile = profiles[0]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	if *from != "" && *check {
+		os.Exit(checkLayout(*buildDir, *from, *to))
+	}
+
+	client, err := profile.Connect(ctx)
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+	defer client.Close()
+
+	proxy := profile.ContractAddress()
+	implementation, err := api.Implementation(ctx, client, proxy)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if implementation == (common.Address{}) {
+		log.Fatalf("[%s] %s is not a proxy; redeploy and migrate instead", profile.Name, proxy.Hex())
+	}
+	live, version, err := api.LiveContract(ctx, client, proxy)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[%s] %s runs %s (version %d) at %s", profile.Name, proxy.Hex(), live, version, implementation.Hex())
+	if *from != "" && *from != live {
+		log.Fatalf("[%s] -from %s does not match the live %s", profile.Name, *from, live)
+	}
+
+	if code := checkLayout(*buildDir, live, *to); code != 0 || *check {
+		os.Exit(code)
+	}
+
+	artifact, err := bindgen.Load(*buildDir, *to)
+	if err != nil {
+		log.Fatalf("Failed to load %s artifacts: %v", *to, err)
+	}
+	auth, err := profile.Transactor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.Context = ctx
+
+	if *call == "" && version == 1 {
+		*call = "initializeV2"
+	}
+	implementation, err = upgrade.Upgrade(ctx, auth, client, proxy, artifact, *call)
+	if err != nil {
+		log.Fatalf("[%s] Upgrade failed: %v", profile.Name, err)
+	}
+	previous := version
+	version, err = api.LiveVersion(ctx, client, proxy)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[%s] Upgraded to %s at %s, live version %d", profile.Name, *to, implementation.Hex(), version)
+
+	// V2 takes over V1's checkpoints as segment products before it accepts writes
+	if previous == 1 && version >= 2 {
+		migrated, err := upgrade.MigrateCheckpoints(ctx, auth, client, proxy, *batch)
+		if err != nil {
+			log.Fatalf("[%s] Checkpoint migration stopped after %d: %v", profile.Name, migrated, err)
+		}
+		log.Printf("[%s] Migrated %d V1 checkpoints", profile.Name, migrated)
+	}
+}
+
+// Prints the incompatibilities between two pinned layouts and returns the exit code
+func checkLayout(buildDir, from, to string) int {
+	prev, err := upgrade.LoadLayout(bindgen.LayoutPath(buildDir, from))
+	if err != nil {
+		log.Fatalf("Failed to load %s layout: %v", from, err)
+	}
+	next, err := upgrade.LoadLayout(bindgen.LayoutPath(buildDir, to))
+	if err != nil {
+		log.Fatalf("Failed to load %s layout: %v", to, err)
+	}
+	problems := upgrade.CheckLayout(prev, next)
+	for _, problem := range problems {
+		fmt.Fprintf(os.Stderr, "%s -> %s: %s\n", from, to, problem)
+	}
+	if len(problems) > 0 {
+		return 1
+	}
+	log.Printf("Storage layout of %s is compatible with %s", to, from)
+	return 0
+}
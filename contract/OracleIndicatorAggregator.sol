@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./AggregatorV3Interface.sol";
+import "./OracleIndicator.sol";
+
+// Expõe um OracleIndicator no formato AggregatorV3Interface. Cada dia é uma rodada
+// cujo id é o número de dias desde 1970-01-01 (_timestamp / 86400).
+// O adaptador precisa do papel READ_ONLY no OracleIndicator e só repassa leituras
+// de quem o OracleIndicator aceitaria como leitor (canRead), para não abrir os dados.
+contract OracleIndicatorAggregator is AggregatorV3Interface {
+    uint256 public constant VERSION = 1;
+
+    OracleIndicator public immutable oracle;
+
+    error NoDataPresent(uint80 roundId);
+    error ReadAccessDenied(address account);
+
+    constructor(OracleIndicator _oracle) {
+        oracle = _oracle;
+    }
+
+    function decimals() external view returns (uint8) {
+        return oracle.decimal();
+    }
+
+    function description() external view returns (string memory) {
+        return oracle.getName();
+    }
+
+    function version() external pure returns (uint256) {
+        return VERSION;
+    }
+
+    function getRoundData(
+        uint80 _roundId
+    )
+        external
+        view
+        returns (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+    {
+        _checkReader();
+        OracleIndicator.DataFeed memory feed = oracle.getDate(uint256(_roundId) * 86400);
+        if (feed.updatedat == 0) {
+            revert NoDataPresent(_roundId);
+        }
+        return (_roundId, feed.value, uint256(_roundId) * 86400, feed.updatedat, _roundId);
+    }
+
+    function latestRoundData()
+        external
+        view
+        returns (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+    {
+        _checkReader();
+        OracleIndicator.DataFeed memory last = oracle.getLast();
+        if (last.updatedat == 0) {
+            revert NoDataPresent(0);
+        }
+
+        // getLast verifica o acesso, a pausa e a retirada; lastDay informa o dia
+        uint80 id = uint80(oracle.lastDay() / 86400);
+        return (id, last.value, uint256(id) * 86400, last.updatedat, id);
+    }
+
+    function _checkReader() private view {
+        if (!oracle.canRead(msg.sender)) {
+            revert ReadAccessDenied(msg.sender);
+        }
+    }
+}
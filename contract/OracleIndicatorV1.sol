@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/utils/math/Math.sol";
+import "@openzeppelin/contracts-upgradeable/access/AccessControlUpgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/proxy/utils/UUPSUpgradeable.sol";
+
+// Versão do OracleIndicator atrás de um ERC1967Proxy (UUPS).
+// Novas versões só podem acrescentar variáveis ao final do storage.
+// Versão congelada para os proxies já implantados: grava os dias só em ordem, soma
+// intervalos por checkpoints e expõe indicators sem controle de leitura. As correções
+// e as funções novas ficam na OracleIndicatorV2, que converte os checkpoints.
+contract OracleIndicatorV1 is Initializable, AccessControlUpgradeable, UUPSUpgradeable {
+    bytes32 public constant READ_ONLY = keccak256("READ_ONLY");
+    uint8 private decimals;
+    string private name;
+
+    struct DataFeed {
+        int256 value;
+        uint256 updatedat;
+        uint8 decimal;
+        uint8 confidence;
+    }
+
+    struct Checkpoint {
+        uint256 day;
+        uint256 cumulative;
+    }
+
+    uint256 constant PRECISION = 1e8;
+    uint256 constant CHECKPOINT_PRECISION = 1e36;
+    mapping(uint256 => DataFeed) public indicators;
+    DataFeed private lastIndicator;
+    Checkpoint[] private checkpoints;
+
+    error IndicatorOutOfOrder(uint256 day, uint256 lastDay);
+    error CheckpointUnderflow(uint256 day);
+
+    /// @custom:oz-upgrades-unsafe-allow constructor
+    constructor() {
+        _disableInitializers();
+    }
+
+    function initialize(string memory _name, uint8 _decimals, address _defaultAdmin) external initializer {
+        __AccessControl_init();
+        __UUPSUpgradeable_init();
+        decimals = _decimals;
+        name = _name;
+        _grantRole(DEFAULT_ADMIN_ROLE, _defaultAdmin);
+    }
+
+    // Versão da implementação, lida pelo Go para escolher o binding
+    function version() external pure virtual returns (uint64) {
+        return 1;
+    }
+
+    function _authorizeUpgrade(address) internal override onlyRole(DEFAULT_ADMIN_ROLE) {}
+
+    function saveIndicator(
+        uint256 _timestamp,
+        int256 _value,
+        uint256 _updatedat,
+        uint8 _confidence
+    ) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400); // Arredonda _updatedat para o início do dia (00:00:00)
+        lastIndicator = DataFeed({
+            value: _value,
+            updatedat: _updatedat,
+            decimal: decimals,
+            confidence: _confidence
+        });
+        indicators[dayStartTimestamp] = lastIndicator;
+        _writeCheckpoint(dayStartTimestamp, _value);
+    }
+
+    function getLast() external view onlyRole(READ_ONLY) returns (DataFeed memory) {
+        return lastIndicator;
+    }
+
+    function getDate(
+        uint256 _timestamp
+    ) external view onlyRole(READ_ONLY) returns (DataFeed memory) {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400); // Arredonda _timestamp para o início do dia (00:00:00)
+        return indicators[dayStartTimestamp];
+    }
+
+    function getInterval(
+        uint256 _start,
+        uint256 _end
+    ) external view onlyRole(READ_ONLY) returns (int256) {
+        uint256 startDayTimestamp = _start - (_start % 86400); // Arredonda _start para o início do dia (00:00:00)
+        uint256 endDayTimestamp = _end - (_end % 86400); // Arredonda _end para o início do dia (00:00:00)
+
+        uint256 productValue = PRECISION;
+        for (uint256 i = startDayTimestamp; i <= endDayTimestamp; i += 86400) {
+            if (indicators[i].value >= 0) {
+                productValue = Math.mulDiv(
+                    productValue,
+                    uint256(indicators[i].value),
+                    PRECISION
+                );
+            }
+        }
+
+        return int256(productValue);
+    }
+
+    function getCumulativeInterval(
+        uint256 _start,
+        uint256 _end
+    ) external view onlyRole(READ_ONLY) returns (int256) {
+        uint256 startDayTimestamp = _start - (_start % 86400);
+        uint256 endDayTimestamp = _end - (_end % 86400);
+
+        uint256 upper = _checkpointsUpTo(endDayTimestamp);
+        uint256 lower = startDayTimestamp == 0 ? 0 : _checkpointsUpTo(startDayTimestamp - 1);
+        if (upper <= lower) {
+            return int256(PRECISION);
+        }
+
+        uint256 base = lower == 0 ? CHECKPOINT_PRECISION : checkpoints[lower - 1].cumulative;
+        if (base == 0) {
+            revert CheckpointUnderflow(checkpoints[lower - 1].day);
+        }
+
+        return int256(Math.mulDiv(checkpoints[upper - 1].cumulative, PRECISION, base));
+    }
+
+    function checkpointCount() external view returns (uint256) {
+        return checkpoints.length;
+    }
+
+    function _writeCheckpoint(uint256 _day, int256 _value) private {
+        uint256 length = checkpoints.length;
+        uint256 previous = CHECKPOINT_PRECISION;
+
+        if (length > 0) {
+            Checkpoint storage last = checkpoints[length - 1];
+            if (_day < last.day) {
+                revert IndicatorOutOfOrder(_day, last.day);
+            }
+            if (_day == last.day) {
+                // Regrava o último dia a partir do checkpoint anterior
+                previous = length > 1 ? checkpoints[length - 2].cumulative : CHECKPOINT_PRECISION;
+                checkpoints.pop();
+            } else {
+                previous = last.cumulative;
+            }
+        }
+
+        uint256 cumulative = previous;
+        if (_value > 0) {
+            cumulative = Math.mulDiv(previous, uint256(_value), PRECISION);
+        }
+        checkpoints.push(Checkpoint({day: _day, cumulative: cumulative}));
+    }
+
+    // Quantidade de checkpoints com dia <= _day (busca binária)
+    function _checkpointsUpTo(uint256 _day) private view returns (uint256) {
+        uint256 low = 0;
+        uint256 high = checkpoints.length;
+        while (low < high) {
+            uint256 mid = (low + high) / 2;
+            if (checkpoints[mid].day > _day) {
+                high = mid;
+            } else {
+                low = mid + 1;
+            }
+        }
+        return low;
+    }
+
+    function decimal() external view returns (uint8) {
+        return decimals;
+    }
+
+    function getName() external view returns (string memory) {
+        return name;
+    }
+}
\ No newline at end of file
@@ -0,0 +1,677 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/utils/math/Math.sol";
+import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+import "@openzeppelin/contracts-upgradeable/access/AccessControlUpgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/proxy/utils/UUPSUpgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/utils/PausableUpgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/utils/cryptography/EIP712Upgradeable.sol";
+
+// Segunda versão atrás do ERC1967Proxy, com as funções do OracleIndicator.
+// O storage repete o da V1 e só acrescenta variáveis depois de checkpoints.
+contract OracleIndicatorV2 is
+    Initializable,
+    AccessControlUpgradeable,
+    PausableUpgradeable,
+    EIP712Upgradeable,
+    UUPSUpgradeable
+{
+    bytes32 public constant READ_ONLY = keccak256("READ_ONLY");
+    // Chave que grava os valores diários; não pode conceder nem revogar papéis
+    bytes32 public constant PUBLISHER_ROLE = keccak256("PUBLISHER_ROLE");
+    // Quem pode assinar relatórios e enviar valores para consenso
+    bytes32 public constant REPORTER_ROLE = keccak256("REPORTER_ROLE");
+    // Resposta a incidentes: pausa as leituras e invalida dias publicados com dados errados
+    bytes32 public constant GUARDIAN_ROLE = keccak256("GUARDIAN_ROLE");
+    bytes32 public constant REPORT_TYPEHASH =
+        keccak256("Report(uint256 timestamp,int256 value,uint256 updatedat,uint8 confidence,uint256 deadline)");
+    uint8 private decimals;
+    string private name;
+
+    struct DataFeed {
+        int256 value;
+        uint256 updatedat;
+        uint8 decimal;
+        uint8 confidence;
+    }
+
+    struct Checkpoint {
+        uint256 day;
+        uint256 cumulative;
+    }
+
+    uint256 constant PRECISION = 1e8;
+    // Precisão dos produtos por bloco, para que poucos dias pequenos não zerem o produto
+    uint256 constant SEGMENT_PRECISION = 1e36;
+    // Dias de cada bloco; uma consulta lê os dias das pontas e um produto por bloco inteiro
+    uint256 constant SEGMENT_DAYS = 16;
+    uint256 constant SEGMENT = SEGMENT_DAYS * 86400;
+    // Privado para que os modos de acesso não sejam contornados; lido por getDay
+    mapping(uint256 => DataFeed) private indicators;
+    DataFeed private lastIndicator;
+    // Checkpoints gravados pela V1; migrateCheckpoints os converte em blocos
+    Checkpoint[] private checkpoints;
+    // Checkpoints da V1 já convertidos
+    uint256 private migratedCheckpoints;
+    // Produto dos valores positivos de cada bloco em SEGMENT_PRECISION; 0 marca bloco sem dias
+    mapping(uint256 => uint256) private segments;
+    // Dia mais recente gravado, o de getLast
+    uint256 public lastDay;
+    // Quantidade de dias gravados
+    uint256 private storedDays;
+    // Relatórios assinados já aplicados por signatário, para que não possam ser reenviados
+    mapping(bytes32 => mapping(address => bool)) public submittedReports;
+
+    // Valores enviados pelos reporters para um dia ainda não finalizado
+    struct Round {
+        int256[] values;
+        uint256 updatedat;
+        bool finalized;
+        mapping(address => bool) submitted;
+    }
+
+    uint256 constant BPS = 10000;
+    // Maior quorum aceito por setConsensus
+    uint8 public constant MAX_QUORUM = 16;
+    // Envios concordantes necessários para finalizar um dia (0 desativa o consenso)
+    uint8 public quorum;
+    // Distância máxima da mediana, em pontos-base, para um envio concordar
+    uint16 public toleranceBps;
+    mapping(uint256 => Round) private rounds;
+
+    // Quem pode chamar getLast, getDate, getInterval e getCumulativeInterval
+    enum AccessMode {
+        Allowlisted,
+        Public,
+        AllowlistedWithExpiry
+    }
+
+    AccessMode public accessMode;
+    // Fim do acesso de leitura de cada consumidor no modo AllowlistedWithExpiry (0 = sem prazo)
+    mapping(address => uint256) public readExpiry;
+
+    // Dias invalidados; as leituras que os incluem revertem até serem regravados
+    mapping(uint256 => bool) public retracted;
+    uint256[] private retractedDays;
+
+    // Faixa aceita para cada valor gravado; mínimo e máximo iguais a zero desativam a faixa
+    int256 public minValue;
+    int256 public maxValue;
+    // Variação máxima em relação ao último valor, em pontos-base (0 desativa)
+    uint16 public maxDeviationBps;
+
+    event AccessModeChanged(AccessMode mode);
+    event ReadAccessGranted(address indexed account, uint256 expiresAt);
+    event ReadAccessRevoked(address indexed account);
+    event IndicatorInvalidated(uint256 indexed day, address account);
+    event IndicatorRestored(uint256 indexed day);
+    event LimitsChanged(int256 minValue, int256 maxValue, uint16 maxDeviationBps);
+    event LimitsOverridden(uint256 indexed day, int256 value, address account);
+    event ConsensusChanged(uint8 quorum, uint16 toleranceBps);
+    event CheckpointsMigrated(uint256 migrated, uint256 remaining);
+
+    error InvalidSignature();
+    error UnauthorizedReporter(address signer);
+    error ReportAlreadySubmitted(bytes32 digest, address signer);
+    error ReportExpired(uint256 deadline);
+    error StaleReport(uint256 day, uint256 updatedat);
+    error ConsensusDisabled();
+    error ConsensusEnabled();
+    error InvalidConsensus(uint8 quorum, uint16 toleranceBps);
+    error DayAlreadyFinalized(uint256 day);
+    error AlreadySubmitted(uint256 day, address reporter);
+    error ReadAccessExpired(address account, uint256 expiresAt);
+    error LengthMismatch(uint256 accounts, uint256 expiries);
+    error IndicatorRetracted(uint256 day);
+    error IndicatorNotFound(uint256 day);
+    error InvalidLimits(int256 minValue, int256 maxValue);
+    error ValueOutOfBounds(int256 value, int256 minValue, int256 maxValue);
+    error DeviationTooLarge(int256 value, int256 last, uint16 maxDeviationBps);
+    error MigrationPending(uint256 remaining);
+
+    /// @custom:oz-upgrades-unsafe-allow constructor
+    constructor() {
+        _disableInitializers();
+    }
+
+    function initialize(string memory _name, uint8 _decimals, address _defaultAdmin) external initializer {
+        __AccessControl_init();
+        __Pausable_init();
+        __EIP712_init("OracleIndicator", "1");
+        __UUPSUpgradeable_init();
+        decimals = _decimals;
+        name = _name;
+        _grantRole(DEFAULT_ADMIN_ROLE, _defaultAdmin);
+        // O admin publica até conceder PUBLISHER_ROLE a outra chave e renunciar ao seu
+        _grantRole(PUBLISHER_ROLE, _defaultAdmin);
+        _grantRole(GUARDIAN_ROLE, _defaultAdmin);
+    }
+
+    // Chamada no upgrade de uma V1, via upgradeToAndCall; o admin que a chama
+    // recebe os papéis que a V1 não tinha
+    function initializeV2() external reinitializer(2) onlyRole(DEFAULT_ADMIN_ROLE) {
+        __Pausable_init();
+        __EIP712_init("OracleIndicator", "1");
+        _grantRole(PUBLISHER_ROLE, msg.sender);
+        _grantRole(GUARDIAN_ROLE, msg.sender);
+    }
+
+    // Versão da implementação, lida pelo Go para escolher o binding
+    function version() external pure virtual returns (uint64) {
+        return 2;
+    }
+
+    function _authorizeUpgrade(address) internal override onlyRole(DEFAULT_ADMIN_ROLE) {}
+
+    // Converte até _count checkpoints da V1 em dias contados e produtos por bloco.
+    // Os checkpoints estão em ordem de dia, então cada um é acrescentado depois do anterior;
+    // até o fim da conversão as gravações e getCumulativeInterval revertem
+    function migrateCheckpoints(uint256 _count) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        uint256 length = checkpoints.length;
+        uint256 next = migratedCheckpoints;
+        uint256 end = next + _count > length ? length : next + _count;
+        for (; next < end; next++) {
+            uint256 day = checkpoints[next].day;
+            storedDays++;
+            lastDay = day;
+            _writeSegment(day, indicators[day].value, true);
+        }
+        migratedCheckpoints = end;
+        emit CheckpointsMigrated(end, length - end);
+    }
+
+    // Checkpoints da V1 ainda não convertidos
+    function pendingCheckpoints() public view returns (uint256) {
+        return checkpoints.length - migratedCheckpoints;
+    }
+
+    function _requireMigrated() private view {
+        uint256 remaining = pendingCheckpoints();
+        if (remaining > 0) {
+            revert MigrationPending(remaining);
+        }
+    }
+
+    modifier onlyReader() {
+        _checkReader(msg.sender);
+        _;
+    }
+
+    function saveIndicator(
+        uint256 _timestamp,
+        int256 _value,
+        uint256 _updatedat,
+        uint8 _confidence
+    ) external onlyRole(PUBLISHER_ROLE) {
+        // Com consenso ativo os dias só são gravados pela mediana dos reporters
+        if (quorum > 0) {
+            revert ConsensusEnabled();
+        }
+        _checkLimits(_value);
+        _saveIndicator(_timestamp, _value, _updatedat, _confidence);
+    }
+
+    // Grava um valor fora dos limites, para variações legítimas grandes; também
+    // encerra a rodada de consenso do dia
+    function overrideIndicator(
+        uint256 _timestamp,
+        int256 _value,
+        uint256 _updatedat,
+        uint8 _confidence
+    ) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400);
+        rounds[dayStartTimestamp].finalized = true;
+        _saveIndicator(_timestamp, _value, _updatedat, _confidence);
+        emit LimitsOverridden(dayStartTimestamp, _value, msg.sender);
+    }
+
+    function setLimits(int256 _min, int256 _max, uint16 _maxDeviationBps) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        if (_min > _max) {
+            revert InvalidLimits(_min, _max);
+        }
+        minValue = _min;
+        maxValue = _max;
+        maxDeviationBps = _maxDeviationBps;
+        emit LimitsChanged(_min, _max, _maxDeviationBps);
+    }
+
+    // Último valor contra o qual a variação é medida; inativo sem limite de variação,
+    // sem valor anterior, com último valor zero ou com o último dia invalidado
+    function deviationBase() public view returns (int256 value, bool active) {
+        value = lastIndicator.value;
+        active = maxDeviationBps > 0 && storedDays > 0 && value != 0 && !retracted[lastDay];
+    }
+
+    function _checkLimits(int256 _value) private view {
+        if ((minValue != 0 || maxValue != 0) && (_value < minValue || _value > maxValue)) {
+            revert ValueOutOfBounds(_value, minValue, maxValue);
+        }
+        (int256 last, bool active) = deviationBase();
+        if (!active) {
+            return;
+        }
+        uint256 distance = _value > last ? uint256(_value - last) : uint256(last - _value);
+        uint256 magnitude = last >= 0 ? uint256(last) : uint256(-last);
+        if (distance * BPS > magnitude * maxDeviationBps) {
+            revert DeviationTooLarge(_value, last, maxDeviationBps);
+        }
+    }
+
+    // Aceita de qualquer remetente um relatório assinado (EIP-712) por um REPORTER_ROLE
+    // até o prazo assinado, desde que seja mais novo que o valor já gravado para o dia
+    function submitSignedIndicator(
+        uint256 _timestamp,
+        int256 _value,
+        uint256 _updatedat,
+        uint8 _confidence,
+        uint256 _deadline,
+        bytes calldata _signature
+    ) external {
+        if (block.timestamp > _deadline) {
+            revert ReportExpired(_deadline);
+        }
+        bytes32 digest = reportDigest(_timestamp, _value, _updatedat, _confidence, _deadline);
+        (address signer, ECDSA.RecoverError error, ) = ECDSA.tryRecover(digest, _signature);
+        if (error != ECDSA.RecoverError.NoError) {
+            revert InvalidSignature();
+        }
+        if (!hasRole(REPORTER_ROLE, signer)) {
+            revert UnauthorizedReporter(signer);
+        }
+        if (submittedReports[digest][signer]) {
+            revert ReportAlreadySubmitted(digest, signer);
+        }
+        // Um relatório antigo não pode sobrescrever uma correção mais recente do mesmo dia
+        uint256 day = _timestamp - (_timestamp % 86400);
+        uint256 stored = indicators[day].updatedat;
+        if (stored != 0 && _updatedat <= stored) {
+            revert StaleReport(day, stored);
+        }
+        submittedReports[digest][signer] = true;
+        // Com consenso ativo o relatório conta como o envio do signatário
+        if (quorum > 0) {
+            _submitValue(signer, _timestamp, _value, _updatedat);
+        } else {
+            _checkLimits(_value);
+            _saveIndicator(_timestamp, _value, _updatedat, _confidence);
+        }
+    }
+
+    function setConsensus(uint8 _quorum, uint16 _toleranceBps) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        if (_quorum > MAX_QUORUM || _toleranceBps > BPS) {
+            revert InvalidConsensus(_quorum, _toleranceBps);
+        }
+        quorum = _quorum;
+        toleranceBps = _toleranceBps;
+        emit ConsensusChanged(_quorum, _toleranceBps);
+    }
+
+    // Envio de um reporter; o dia é gravado com a mediana dos envios que concordam
+    // assim que houver quorum deles
+    function submitValue(uint256 _timestamp, int256 _value, uint256 _updatedat) external onlyRole(REPORTER_ROLE) {
+        if (quorum == 0) {
+            revert ConsensusDisabled();
+        }
+        _submitValue(msg.sender, _timestamp, _value, _updatedat);
+    }
+
+    function consensusRound(
+        uint256 _timestamp
+    ) external view returns (uint256 submissions, bool finalized) {
+        Round storage round = rounds[_timestamp - (_timestamp % 86400)];
+        return (round.values.length, round.finalized);
+    }
+
+    function hasSubmitted(uint256 _timestamp, address _reporter) external view returns (bool) {
+        return rounds[_timestamp - (_timestamp % 86400)].submitted[_reporter];
+    }
+
+    function _submitValue(address _reporter, uint256 _timestamp, int256 _value, uint256 _updatedat) private {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400);
+        Round storage round = rounds[dayStartTimestamp];
+        if (round.finalized) {
+            revert DayAlreadyFinalized(dayStartTimestamp);
+        }
+        if (round.submitted[_reporter]) {
+            revert AlreadySubmitted(dayStartTimestamp, _reporter);
+        }
+        round.submitted[_reporter] = true;
+        round.values.push(_value);
+        if (_updatedat > round.updatedat) {
+            round.updatedat = _updatedat;
+        }
+
+        int256[] memory sorted = _sort(round.values);
+        int256 median = _median(sorted, sorted.length);
+
+        // Os concordantes ficam contíguos e continuam ordenados
+        uint256 agreeing = 0;
+        for (uint256 i = 0; i < sorted.length; i++) {
+            if (_agrees(sorted[i], median)) {
+                sorted[agreeing++] = sorted[i];
+            }
+        }
+        if (agreeing < quorum) {
+            return;
+        }
+
+        // Uma mediana fora dos limites reverte o envio; o dia fica aberto até um overrideIndicator
+        int256 value = _median(sorted, agreeing);
+        _checkLimits(value);
+        round.finalized = true;
+        uint8 confidence = uint8((agreeing * 100) / sorted.length);
+        _saveIndicator(dayStartTimestamp, value, round.updatedat, confidence);
+    }
+
+    function _agrees(int256 _value, int256 _median) private view returns (bool) {
+        uint256 distance = _value > _median ? uint256(_value - _median) : uint256(_median - _value);
+        uint256 magnitude = _median >= 0 ? uint256(_median) : uint256(-_median);
+        return distance * BPS <= magnitude * toleranceBps;
+    }
+
+    // Mediana dos primeiros _length valores ordenados; com quantidade par, a média dos dois centrais
+    function _median(int256[] memory _sorted, uint256 _length) private pure returns (int256) {
+        uint256 middle = _length / 2;
+        if (_length % 2 == 1) {
+            return _sorted[middle];
+        }
+        return _sorted[middle - 1] + (_sorted[middle] - _sorted[middle - 1]) / 2;
+    }
+
+    // Ordenação por inserção de uma cópia; poucos reporters por dia
+    function _sort(int256[] storage _values) private view returns (int256[] memory) {
+        int256[] memory sorted = _values;
+        for (uint256 i = 1; i < sorted.length; i++) {
+            int256 current = sorted[i];
+            uint256 j = i;
+            while (j > 0 && sorted[j - 1] > current) {
+                sorted[j] = sorted[j - 1];
+                j--;
+            }
+            sorted[j] = current;
+        }
+        return sorted;
+    }
+
+    // Com a pausa as leituras revertem; a publicação continua para permitir correções
+    function pause() external onlyRole(GUARDIAN_ROLE) {
+        _pause();
+    }
+
+    function unpause() external onlyRole(GUARDIAN_ROLE) {
+        _unpause();
+    }
+
+    // Marca um dia gravado como retirado; regravar o dia ou chamar restore o restaura
+    function invalidate(uint256 _timestamp) external onlyRole(GUARDIAN_ROLE) {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400);
+        if (indicators[dayStartTimestamp].updatedat == 0) {
+            revert IndicatorNotFound(dayStartTimestamp);
+        }
+        if (retracted[dayStartTimestamp]) {
+            return;
+        }
+        retracted[dayStartTimestamp] = true;
+        retractedDays.push(dayStartTimestamp);
+        emit IndicatorInvalidated(dayStartTimestamp, msg.sender);
+    }
+
+    // Desfaz uma retirada sem regravar o dia, mantendo valor e produtos por bloco
+    function restore(uint256 _timestamp) external onlyRole(GUARDIAN_ROLE) {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400);
+        if (retracted[dayStartTimestamp]) {
+            _restore(dayStartTimestamp);
+        }
+    }
+
+    function retractedCount() external view returns (uint256) {
+        return retractedDays.length;
+    }
+
+    function setAccessMode(AccessMode _mode) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        accessMode = _mode;
+        emit AccessModeChanged(_mode);
+    }
+
+    // Concede READ_ONLY em lote; o prazo só é aplicado no modo AllowlistedWithExpiry
+    function grantReadAccess(
+        address[] calldata _accounts,
+        uint256[] calldata _expiries
+    ) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        if (_accounts.length != _expiries.length) {
+            revert LengthMismatch(_accounts.length, _expiries.length);
+        }
+        for (uint256 i = 0; i < _accounts.length; i++) {
+            _grantRole(READ_ONLY, _accounts[i]);
+            readExpiry[_accounts[i]] = _expiries[i];
+            emit ReadAccessGranted(_accounts[i], _expiries[i]);
+        }
+    }
+
+    function revokeReadAccess(address[] calldata _accounts) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        for (uint256 i = 0; i < _accounts.length; i++) {
+            _revokeRole(READ_ONLY, _accounts[i]);
+            emit ReadAccessRevoked(_accounts[i]);
+        }
+    }
+
+    // Apaga o prazo junto com READ_ONLY, inclusive via revokeRole e renounceRole,
+    // para que uma nova concessão não herde o prazo antigo
+    function _revokeRole(bytes32 _role, address _account) internal override returns (bool) {
+        if (_role == READ_ONLY) {
+            delete readExpiry[_account];
+        }
+        return super._revokeRole(_role, _account);
+    }
+
+    function canRead(address _account) external view returns (bool) {
+        if (accessMode == AccessMode.Public) {
+            return true;
+        }
+        if (!hasRole(READ_ONLY, _account)) {
+            return false;
+        }
+        uint256 expiry = readExpiry[_account];
+        return accessMode == AccessMode.Allowlisted || expiry == 0 || block.timestamp < expiry;
+    }
+
+    function _checkReader(address _account) private view {
+        if (accessMode == AccessMode.Public) {
+            return;
+        }
+        _checkRole(READ_ONLY, _account);
+        uint256 expiry = readExpiry[_account];
+        if (accessMode == AccessMode.AllowlistedWithExpiry && expiry != 0 && block.timestamp >= expiry) {
+            revert ReadAccessExpired(_account, expiry);
+        }
+    }
+
+    // Hash EIP-712 que o reporter assina
+    function reportDigest(
+        uint256 _timestamp,
+        int256 _value,
+        uint256 _updatedat,
+        uint8 _confidence,
+        uint256 _deadline
+    ) public view returns (bytes32) {
+        return _hashTypedDataV4(
+            keccak256(abi.encode(REPORT_TYPEHASH, _timestamp, _value, _updatedat, _confidence, _deadline))
+        );
+    }
+
+    // Grava qualquer dia, inclusive anteriores ao último (correções e preenchimentos);
+    // getLast só acompanha o dia mais recente
+    function _saveIndicator(uint256 _timestamp, int256 _value, uint256 _updatedat, uint8 _confidence) private {
+        _requireMigrated();
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400); // Arredonda _updatedat para o início do dia (00:00:00)
+        DataFeed memory feed = DataFeed({
+            value: _value,
+            updatedat: _updatedat,
+            decimal: decimals,
+            confidence: _confidence
+        });
+        bool appended = storedDays == 0 || dayStartTimestamp > lastDay;
+        if (indicators[dayStartTimestamp].updatedat == 0) {
+            storedDays++;
+        }
+        indicators[dayStartTimestamp] = feed;
+        if (dayStartTimestamp >= lastDay) {
+            lastDay = dayStartTimestamp;
+            lastIndicator = feed;
+        }
+        _writeSegment(dayStartTimestamp, _value, appended);
+        if (retracted[dayStartTimestamp]) {
+            _restore(dayStartTimestamp);
+        }
+    }
+
+    function _restore(uint256 _day) private {
+        retracted[_day] = false;
+        uint256 length = retractedDays.length;
+        for (uint256 i = 0; i < length; i++) {
+            if (retractedDays[i] == _day) {
+                retractedDays[i] = retractedDays[length - 1];
+                retractedDays.pop();
+                break;
+            }
+        }
+        emit IndicatorRestored(_day);
+    }
+
+    function _requireNotRetracted(uint256 _day) private view {
+        if (retracted[_day]) {
+            revert IndicatorRetracted(_day);
+        }
+    }
+
+    // Dia gravado como está, mesmo retirado, com a marca de retirada; os leitores não
+    // o veem durante a pausa, os papéis operacionais o consultam em qualquer modo e na pausa
+    function getDay(uint256 _timestamp) external view returns (DataFeed memory feed, bool isRetracted) {
+        if (!_isOperator(msg.sender)) {
+            _checkReader(msg.sender);
+            _requireNotPaused();
+        }
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400);
+        return (indicators[dayStartTimestamp], retracted[dayStartTimestamp]);
+    }
+
+    function _isOperator(address _account) private view returns (bool) {
+        return
+            hasRole(DEFAULT_ADMIN_ROLE, _account) ||
+            hasRole(PUBLISHER_ROLE, _account) ||
+            hasRole(GUARDIAN_ROLE, _account);
+    }
+
+    function getLast() external view onlyReader whenNotPaused returns (DataFeed memory) {
+        if (storedDays > 0) {
+            _requireNotRetracted(lastDay);
+        }
+        return lastIndicator;
+    }
+
+    function getDate(
+        uint256 _timestamp
+    ) external view onlyReader whenNotPaused returns (DataFeed memory) {
+        uint256 dayStartTimestamp = _timestamp - (_timestamp % 86400); // Arredonda _timestamp para o início do dia (00:00:00)
+        _requireNotRetracted(dayStartTimestamp);
+        return indicators[dayStartTimestamp];
+    }
+
+    function getInterval(
+        uint256 _start,
+        uint256 _end
+    ) external view onlyReader whenNotPaused returns (int256) {
+        uint256 startDayTimestamp = _start - (_start % 86400); // Arredonda _start para o início do dia (00:00:00)
+        uint256 endDayTimestamp = _end - (_end % 86400); // Arredonda _end para o início do dia (00:00:00)
+
+        uint256 productValue = PRECISION;
+        for (uint256 i = startDayTimestamp; i <= endDayTimestamp; i += 86400) {
+            _requireNotRetracted(i);
+            if (indicators[i].value >= 0) {
+                productValue = Math.mulDiv(
+                    productValue,
+                    uint256(indicators[i].value),
+                    PRECISION
+                );
+            }
+        }
+
+        return int256(productValue);
+    }
+
+    function getCumulativeInterval(
+        uint256 _start,
+        uint256 _end
+    ) external view onlyReader whenNotPaused returns (int256) {
+        _requireMigrated();
+        uint256 startDayTimestamp = _start - (_start % 86400);
+        uint256 endDayTimestamp = _end - (_end % 86400);
+        // Os produtos dos blocos incluem o valor retirado, então o intervalo não pode incluí-lo
+        for (uint256 i = 0; i < retractedDays.length; i++) {
+            if (retractedDays[i] >= startDayTimestamp && retractedDays[i] <= endDayTimestamp) {
+                revert IndicatorRetracted(retractedDays[i]);
+            }
+        }
+
+        // Dias avulsos nas pontas e um produto por bloco inteiro no meio
+        uint256 product = SEGMENT_PRECISION;
+        uint256 day = startDayTimestamp;
+        while (day <= endDayTimestamp) {
+            if (day % SEGMENT == 0 && day + SEGMENT - 86400 <= endDayTimestamp) {
+                uint256 segment = segments[day / SEGMENT];
+                if (segment != 0) {
+                    product = Math.mulDiv(product, segment, SEGMENT_PRECISION);
+                }
+                day += SEGMENT;
+            } else {
+                int256 value = indicators[day].value;
+                if (value > 0) {
+                    product = Math.mulDiv(product, uint256(value), PRECISION);
+                }
+                day += 86400;
+            }
+        }
+
+        return int256(product / (SEGMENT_PRECISION / PRECISION));
+    }
+
+    // Quantidade de dias gravados
+    function checkpointCount() external view returns (uint256) {
+        return storedDays;
+    }
+
+    // Um dia novo depois do último multiplica o produto do bloco; uma correção ou um
+    // preenchimento recalcula o bloco a partir dos dias gravados
+    function _writeSegment(uint256 _day, int256 _value, bool _appended) private {
+        uint256 index = _day / SEGMENT;
+        uint256 product = segments[index];
+        if (_appended) {
+            if (product == 0) {
+                product = SEGMENT_PRECISION;
+            }
+            if (_value > 0) {
+                product = Math.mulDiv(product, uint256(_value), PRECISION);
+            }
+        } else {
+            product = SEGMENT_PRECISION;
+            uint256 first = index * SEGMENT;
+            for (uint256 day = first; day < first + SEGMENT; day += 86400) {
+                int256 value = indicators[day].value;
+                if (value > 0) {
+                    product = Math.mulDiv(product, uint256(value), PRECISION);
+                }
+            }
+        }
+        // Um produto abaixo da precisão fica em 1 para não se confundir com bloco vazio
+        segments[index] = product == 0 ? 1 : product;
+    }
+
+    function decimal() external view returns (uint8) {
+        return decimals;
+    }
+
+    function getName() external view returns (string memory) {
+        return name;
+    }
+}
\ No newline at end of file
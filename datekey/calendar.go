@@ -0,0 +1,72 @@
+package datekey
+
+import (
+	"time"
+)
+
+// Whether the BCB publishes a value for this date: weekdays that are not
+// Brazilian national holidays
+func (k Key) IsBusinessDay() bool {
+	switch k.Time().Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !isHoliday(k)
+}
+
+// Closest business day strictly before k
+func (k Key) PreviousBusinessDay() Key {
+	day := k - 1
+	for !day.IsBusinessDay() {
+		day--
+	}
+	return day
+}
+
+// National holidays observed by the financial system
+func isHoliday(k Key) bool {
+	year, month, day := k.Time().Date()
+
+	switch {
+	case month == time.January && day == 1,
+		month == time.April && day == 21,
+		month == time.May && day == 1,
+		month == time.September && day == 7,
+		month == time.October && day == 12,
+		month == time.November && day == 2,
+		month == time.November && day == 15,
+		month == time.November && day == 20 && year >= 2024,
+		month == time.December && day == 25:
+		return true
+	}
+
+	easter := easterSunday(year)
+	switch k - easter {
+	case -48, -47: // carnaval
+		return true
+	case -2: // sexta-feira santa
+		return true
+	case 60: // corpus christi
+		return true
+	}
+	return false
+}
+
+// Gregorian Easter (anonymous algorithm)
+func easterSunday(year int) Key {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return FromDate(year, time.Month(month), day)
+}
@@ -0,0 +1,102 @@
+package datekey
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+	_ "time/tzdata"
+)
+
+// Seconds in a contract day bucket
+const secondsPerDay = 86400
+
+// Date format used by the BCB SGS API
+const Layout = "02/01/2006"
+
+// Calendar of the BCB series
+var Location = mustLoad("America/Sao_Paulo")
+
+// Key identifies a business day as the number of days since 1970-01-01.
+// Its Timestamp is UTC midnight of that calendar date, which is exactly the
+// dayStartTimestamp the contract computes as _timestamp - (_timestamp % 86400),
+// so the same key is used to write with saveIndicator and read with getDate/getInterval.
+// The calendar date always comes from the BCB (Sao Paulo) calendar, never from the
+// UTC date of an instant, so DST changes and historical offsets cannot shift a day.
+type Key int64
+
+// Key of a calendar date
+func FromDate(year int, month time.Month, day int) Key {
+	return Key(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay)
+}
+
+// Key of a dd/mm/yyyy date as published by the BCB
+func Parse(value string) (Key, error) {
+	date, err := time.Parse(Layout, value)
+	if err != nil {
+		return 0, err
+	}
+	return FromDate(date.Date()), nil
+}
+
+// Key of the Sao Paulo calendar date an instant falls on
+func FromTime(t time.Time) Key {
+	return FromDate(t.In(Location).Date())
+}
+
+// Key of the current Sao Paulo business day
+func Today() Key {
+	return FromTime(time.Now())
+}
+
+// Key of the contract bucket a timestamp falls into, mirroring the on-chain rounding
+func FromTimestamp(timestamp int64) Key {
+	day := timestamp / secondsPerDay
+	if timestamp%secondsPerDay < 0 {
+		day--
+	}
+	return Key(day)
+}
+
+// Contract day bucket (UTC midnight of the calendar date)
+func (k Key) Unix() int64 {
+	return int64(k) * secondsPerDay
+}
+
+// Contract day bucket as passed to the generated bindings
+func (k Key) Timestamp() *big.Int {
+	return big.NewInt(k.Unix())
+}
+
+// Calendar date at midnight UTC
+func (k Key) Time() time.Time {
+	return time.Unix(k.Unix(), 0).UTC()
+}
+
+// Start of the calendar date in Sao Paulo. On days where DST began at
+// midnight the first existing local instant (01:00) is returned.
+func (k Key) Local() time.Time {
+	year, month, day := k.Time().Date()
+	t := time.Date(year, month, day, 0, 0, 0, 0, Location)
+	for FromTime(t) < k {
+		t = t.Add(time.Hour)
+	}
+	return t
+}
+
+// Following calendar day
+func (k Key) Next() Key {
+	return k + 1
+}
+
+// Date in the BCB dd/mm/yyyy format
+func (k Key) String() string {
+	return k.Time().Format(Layout)
+}
+
+func mustLoad(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(fmt.Sprintf("datekey: %v", err))
+	}
+	return loc
+}
@@ -0,0 +1,143 @@
+package datekey
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// Keys between 1900 and 2100, covering the LMT offset before 1914 and every Brazilian DST period
+type randomKey Key
+
+func (randomKey) Generate(r *rand.Rand, _ int) reflect.Value {
+	first, last := FromDate(1900, time.January, 1), FromDate(2100, time.December, 31)
+	return reflect.ValueOf(randomKey(first + Key(r.Int63n(int64(last-first)))))
+}
+
+var config = &quick.Config{MaxCount: 20000}
+
+func TestTimestampIsContractBucket(t *testing.T) {
+	f := func(k randomKey, offset uint32) bool {
+		key := Key(k)
+		ts := key.Unix()
+		inside := ts + int64(offset%secondsPerDay)
+		return ts%secondsPerDay == 0 && FromTimestamp(ts) == key && FromTimestamp(inside) == key
+	}
+	if err := quick.Check(f, config); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestStringRoundTrip(t *testing.T) {
+	f := func(k randomKey) bool {
+		parsed, err := Parse(Key(k).String())
+		return err == nil && parsed == Key(k)
+	}
+	if err := quick.Check(f, config); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLocalDayRoundTrip(t *testing.T) {
+	f := func(k randomKey, offset uint32) bool {
+		key := Key(k)
+		start := key.Local()
+		if FromTime(start) != key {
+			return false
+		}
+		// any instant before the next local midnight belongs to the same day
+		inside := start.Add(time.Duration(offset) % key.Next().Local().Sub(start))
+		return FromTime(inside) == key
+	}
+	if err := quick.Check(f, config); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDaysAreContiguous(t *testing.T) {
+	f := func(k randomKey) bool {
+		key := Key(k)
+		length := key.Next().Local().Sub(key.Local())
+		return key.Next().Unix()-key.Unix() == secondsPerDay &&
+			length >= 23*time.Hour && length <= 25*time.Hour
+	}
+	if err := quick.Check(f, config); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		instant time.Time
+		want    string
+	}{
+		// DST started at midnight, so 00:00 local never happened
+		{"dst start 2018", time.Date(2018, time.November, 4, 3, 0, 0, 0, time.UTC), "04/11/2018"},
+		{"before dst start 2018", time.Date(2018, time.November, 4, 2, 59, 59, 0, time.UTC), "03/11/2018"},
+		// DST ended at midnight, so 23:00-23:59 local happened twice on the 16th
+		{"repeated hour 2019", time.Date(2019, time.February, 17, 2, 30, 0, 0, time.UTC), "16/02/2019"},
+		{"dst end 2019", time.Date(2019, time.February, 17, 3, 0, 0, 0, time.UTC), "17/02/2019"},
+		// late evening in Sao Paulo is already the next day in UTC
+		{"evening after utc midnight", time.Date(2024, time.March, 15, 1, 30, 0, 0, time.UTC), "14/03/2024"},
+		// local mean time (-03:06:28) before 1914
+		{"lmt", time.Date(1913, time.June, 1, 3, 6, 27, 0, time.UTC), "31/05/1913"},
+		{"lmt midnight", time.Date(1913, time.June, 1, 3, 6, 28, 0, time.UTC), "01/06/1913"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromTime(tt.instant).String(); got != tt.want {
+				t.Errorf("FromTime(%s) = %s, want %s", tt.instant, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	key, err := Parse("02/01/2006")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC).Unix(); key.Unix() != want {
+		t.Errorf("Parse(02/01/2006).Unix() = %d, want %d", key.Unix(), want)
+	}
+	if _, err := Parse("2006-01-02"); err == nil {
+		t.Error("Parse accepted an ISO date")
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"01/01/2024", false}, // confraternização universal
+		{"12/02/2024", false}, // carnaval
+		{"13/02/2024", false}, // carnaval
+		{"14/02/2024", true},  // quarta-feira de cinzas
+		{"29/03/2024", false}, // sexta-feira santa
+		{"30/05/2024", false}, // corpus christi
+		{"20/11/2023", true},  // consciência negra before it became national
+		{"20/11/2024", false},
+		{"16/03/2024", false}, // saturday
+		{"18/03/2024", true},
+	}
+
+	for _, tt := range tests {
+		key, err := Parse(tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := key.IsBusinessDay(); got != tt.want {
+			t.Errorf("%s.IsBusinessDay() = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	monday, _ := Parse("18/03/2024")
+	if got := monday.PreviousBusinessDay().String(); got != "15/03/2024" {
+		t.Errorf("PreviousBusinessDay of monday = %s, want friday", got)
+	}
+}
@@ -0,0 +1,125 @@
+package emergency
+
+import (
+	"context"
+	"fmt"
+
+	"abi/api"
+	"abi/datekey"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Node access needed to send emergency transactions
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Pauses reads on the contract. Returns a nil receipt when it is already paused.
+func Pause(ctx context.Context, backend Backend, auth *bind.TransactOpts, address common.Address) (*types.Receipt, error) {
+	return setPaused(ctx, backend, auth, address, true)
+}
+
+// Resumes reads on the contract. Returns a nil receipt when it is not paused.
+func Unpause(ctx context.Context, backend Backend, auth *bind.TransactOpts, address common.Address) (*types.Receipt, error) {
+	return setPaused(ctx, backend, auth, address, false)
+}
+
+func setPaused(ctx context.Context, backend Backend, auth *bind.TransactOpts, address common.Address, pause bool) (*types.Receipt, error) {
+	oracle, err := api.NewOracleIndicator(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	paused, err := oracle.Paused(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read paused: %v", err)
+	}
+	if paused == pause {
+		return nil, nil
+	}
+
+	opts := *auth
+	opts.Context = ctx
+	var tx *types.Transaction
+	if pause {
+		tx, err = oracle.Pause(&opts)
+	} else {
+		tx, err = oracle.Unpause(&opts)
+	}
+	if err != nil {
+		return nil, api.DecodeError(err)
+	}
+	return confirm(ctx, backend, tx)
+}
+
+// Marks the stored days as retracted, one transaction per day. Days already
+// retracted are skipped; a day with no stored value stops the run before
+// anything is sent. Returns how many days were invalidated.
+func Invalidate(ctx context.Context, backend Backend, auth *bind.TransactOpts, address common.Address, keys []datekey.Key) (int, error) {
+	return setRetracted(ctx, backend, auth, address, keys, true)
+}
+
+// Clears the retraction of the days without republishing them, one transaction
+// per day. Days that are not retracted are skipped; a day with no stored value
+// stops the run before anything is sent. Returns how many days were restored.
+func Restore(ctx context.Context, backend Backend, auth *bind.TransactOpts, address common.Address, keys []datekey.Key) (int, error) {
+	return setRetracted(ctx, backend, auth, address, keys, false)
+}
+
+func setRetracted(ctx context.Context, backend Backend, auth *bind.TransactOpts, address common.Address, keys []datekey.Key, retract bool) (int, error) {
+	action := "invalidate"
+	if !retract {
+		action = "restore"
+	}
+	oracle, err := api.NewOracleIndicator(address, backend)
+	if err != nil {
+		return 0, err
+	}
+	callOpts := &bind.CallOpts{Context: ctx, From: auth.From}
+
+	var pending []datekey.Key
+	for _, key := range keys {
+		day, err := oracle.GetDay(callOpts, key.Timestamp())
+		if err != nil {
+			return 0, fmt.Errorf("failed to read %s: %v", key, api.DecodeError(err))
+		}
+		if day.Feed.Updatedat.Sign() == 0 {
+			return 0, fmt.Errorf("no value stored for %s", key)
+		}
+		if day.IsRetracted != retract {
+			pending = append(pending, key)
+		}
+	}
+
+	for i, key := range pending {
+		opts := *auth
+		opts.Context = ctx
+		var tx *types.Transaction
+		if retract {
+			tx, err = oracle.Invalidate(&opts, key.Timestamp())
+		} else {
+			tx, err = oracle.Restore(&opts, key.Timestamp())
+		}
+		if err != nil {
+			return i, fmt.Errorf("failed to %s %s: %w", action, key, api.DecodeError(err))
+		}
+		if _, err := confirm(ctx, backend, tx); err != nil {
+			return i, fmt.Errorf("failed to %s %s: %w", action, key, err)
+		}
+	}
+	return len(pending), nil
+}
+
+func confirm(ctx context.Context, backend Backend, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for %s: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%s reverted: %w", tx.Hash().Hex(), api.ReplayRevert(ctx, backend, tx, receipt))
+	}
+	return receipt, nil
+}
@@ -0,0 +1,171 @@
+package emergency_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"abi/api"
+	"abi/datekey"
+	"abi/emergency"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+const day = 86400
+
+// 2024-01-02 00:00:00 UTC
+var day0 = int64(1704153600)
+
+func TestPauseAndInvalidate(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.New(t, "CDI", 8)
+	opts := chain.CallOpts(chain.Admin.From)
+	role, err := chain.Oracle.READONLY(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := chain.Oracle.GrantRole(chain.Admin, role, chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	for i := int64(0); i < 3; i++ {
+		save(t, chain, day0+i*day, 100_040_000)
+	}
+	_, reader := chain.NewAccount(t)
+	tx, err = chain.Oracle.GrantRole(chain.Admin, role, reader.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 20*time.Millisecond)
+
+	// pausing freezes reads but not publishing
+	receipt, err := emergency.Pause(ctx, chain.Client, chain.Admin, chain.Address)
+	if err != nil || receipt == nil {
+		t.Fatalf("pause = %v, %v", receipt, err)
+	}
+	_, err = chain.Oracle.GetLast(opts)
+	simtest.RequireRevert(t, err, "EnforcedPause")
+	_, err = chain.Oracle.GetDate(opts, big.NewInt(day0))
+	simtest.RequireRevert(t, err, "EnforcedPause")
+	// readers cannot reach the raw day either, the operators still can
+	_, err = chain.Oracle.GetDay(chain.CallOpts(reader.From), big.NewInt(day0))
+	simtest.RequireRevert(t, err, "EnforcedPause")
+	if _, err := chain.Oracle.GetDay(opts, big.NewInt(day0)); err != nil {
+		t.Fatalf("operator getDay while paused: %v", api.DecodeError(err))
+	}
+	if receipt, err := emergency.Pause(ctx, chain.Client, chain.Admin, chain.Address); err != nil || receipt != nil {
+		t.Fatalf("second pause = %v, %v", receipt, err)
+	}
+	save(t, chain, day0+2*day, 100_040_000)
+
+	_, outsider := chain.NewAccount(t)
+	if _, err := emergency.Unpause(ctx, chain.Client, outsider, chain.Address); err == nil {
+		t.Fatal("outsider unpaused")
+	}
+	if _, err := emergency.Unpause(ctx, chain.Client, chain.Admin, chain.Address); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Oracle.GetLast(opts); err != nil {
+		t.Fatalf("getLast after unpause: %v", api.DecodeError(err))
+	}
+	paused, err := chain.Oracle.FilterPaused(&bind.FilterOpts{Start: 0, Context: ctx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !paused.Next() || paused.Event.Account != chain.Admin.From {
+		t.Fatal("no Paused event from the admin")
+	}
+
+	// a retracted day fails every read that covers it
+	middle := datekey.FromTimestamp(day0 + day)
+	done, err := emergency.Invalidate(ctx, chain.Client, chain.Admin, chain.Address, []datekey.Key{middle})
+	if err != nil || done != 1 {
+		t.Fatalf("invalidate = %d, %v", done, err)
+	}
+	_, err = chain.Oracle.GetDate(opts, big.NewInt(day0+day+3600))
+	revert := simtest.RequireRevert(t, err, "IndicatorRetracted")
+	if revert.Args[0].(*big.Int).Int64() != day0+day {
+		t.Errorf("revert names day %v", revert.Args[0])
+	}
+	if _, err := chain.Oracle.GetDate(opts, big.NewInt(day0)); err != nil {
+		t.Errorf("getDate of a valid day: %v", api.DecodeError(err))
+	}
+	_, err = chain.Oracle.GetInterval(opts, big.NewInt(day0), big.NewInt(day0+2*day))
+	simtest.RequireRevert(t, err, "IndicatorRetracted")
+	_, err = chain.Oracle.GetCumulativeInterval(opts, big.NewInt(day0), big.NewInt(day0+2*day))
+	simtest.RequireRevert(t, err, "IndicatorRetracted")
+	if _, err := chain.Oracle.GetCumulativeInterval(opts, big.NewInt(day0+2*day), big.NewInt(day0+2*day)); err != nil {
+		t.Errorf("cumulative interval after the retracted day: %v", api.DecodeError(err))
+	}
+
+	invalidated, err := chain.Oracle.FilterIndicatorInvalidated(&bind.FilterOpts{Start: 0, Context: ctx}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !invalidated.Next() || invalidated.Event.Day.Int64() != day0+day {
+		t.Fatal("no IndicatorInvalidated event for the day")
+	}
+
+	if done, err := emergency.Invalidate(ctx, chain.Client, chain.Admin, chain.Address, []datekey.Key{middle}); err != nil || done != 0 {
+		t.Errorf("invalidating again = %d, %v", done, err)
+	}
+	if _, err := emergency.Invalidate(ctx, chain.Client, chain.Admin, chain.Address, []datekey.Key{datekey.FromTimestamp(day0 + 10*day)}); err == nil {
+		t.Error("invalidated a day with no value")
+	}
+
+	// retracting the last day fails getLast until the day is republished
+	last := datekey.FromTimestamp(day0 + 2*day)
+	if _, err := emergency.Invalidate(ctx, chain.Client, chain.Admin, chain.Address, []datekey.Key{last}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = chain.Oracle.GetLast(opts)
+	simtest.RequireRevert(t, err, "IndicatorRetracted")
+	save(t, chain, day0+2*day, 100_050_000)
+	if _, err := chain.Oracle.GetLast(opts); err != nil {
+		t.Fatalf("getLast after republishing: %v", api.DecodeError(err))
+	}
+	if count, err := chain.Oracle.RetractedCount(opts); err != nil || count.Int64() != 1 {
+		t.Errorf("retracted count = %v, %v", count, err)
+	}
+	restored, err := chain.Oracle.FilterIndicatorRestored(&bind.FilterOpts{Start: 0, Context: ctx}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restored.Next() || restored.Event.Day.Int64() != day0+2*day {
+		t.Fatal("no IndicatorRestored event for the republished day")
+	}
+
+	// restore clears a retraction without republishing the day
+	if done, err := emergency.Restore(ctx, chain.Client, chain.Admin, chain.Address, []datekey.Key{middle}); err != nil || done != 1 {
+		t.Fatalf("restore = %d, %v", done, err)
+	}
+	feed, err := chain.Oracle.GetDate(opts, big.NewInt(day0+day))
+	if err != nil || feed.Value.Int64() != 100_040_000 {
+		t.Fatalf("restored day = %+v, %v", feed, api.DecodeError(err))
+	}
+	if _, err := chain.Oracle.GetCumulativeInterval(opts, big.NewInt(day0), big.NewInt(day0+2*day)); err != nil {
+		t.Errorf("cumulative interval after restore: %v", api.DecodeError(err))
+	}
+	if count, err := chain.Oracle.RetractedCount(opts); err != nil || count.Sign() != 0 {
+		t.Errorf("retracted count after restore = %v, %v", count, err)
+	}
+	if done, err := emergency.Restore(ctx, chain.Client, chain.Admin, chain.Address, []datekey.Key{middle}); err != nil || done != 0 {
+		t.Errorf("restoring again = %d, %v", done, err)
+	}
+	_, err = chain.Oracle.Restore(reader, big.NewInt(day0+day))
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+}
+
+func save(t *testing.T, chain *simtest.Chain, timestamp, value int64) {
+	t.Helper()
+	tx, err := chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(timestamp), big.NewInt(value), big.NewInt(timestamp), 100)
+	if err != nil {
+		t.Fatalf("saveIndicator(%d): %v", timestamp, api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+}
@@ -0,0 +1,494 @@
+package failover
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var _ bind.ContractBackend = (*Client)(nil)
+var _ bind.DeployBackend = (*Client)(nil)
+
+// Returned when no endpoint could serve a request
+var ErrNoEndpoint = errors.New("no RPC endpoint available")
+
+// Returned when endpoints answer the same read with different results
+type DisagreementError struct {
+	Method string
+	Block  *big.Int
+	URLs   []string
+}
+
+func (e *DisagreementError) Error() string {
+	return fmt.Sprintf("%s at block %s: endpoints disagree (%s)", e.Method, e.Block, strings.Join(e.URLs, ", "))
+}
+
+// Client settings
+type Config struct {
+	// Endpoints in order of preference
+	URLs []string
+	// Blocks an endpoint may lag behind the highest one and still be healthy
+	MaxLag uint64
+	// Endpoints that must return identical results for reads (0 or 1 disables the check).
+	// It must be below the number of URLs so reads survive one endpoint going down.
+	ReadQuorum int
+	// Timeout for each health probe
+	ProbeTimeout time.Duration
+}
+
+// Health of a single endpoint as of the last check
+type Status struct {
+	URL     string
+	Height  uint64
+	Latency time.Duration
+	Healthy bool
+	Err     error
+}
+
+type endpoint struct {
+	url    string
+	client *ethclient.Client
+	status Status
+}
+
+// Client implements bind.ContractBackend over several RPC endpoints,
+// routing each call to the healthiest one and failing over on transport errors
+type Client struct {
+	cfg       Config
+	mu        sync.Mutex
+	endpoints []*endpoint
+}
+
+// Dials all endpoints and runs an initial health check.
+// It fails only if none of the endpoints can be reached.
+func Dial(ctx context.Context, cfg Config) (*Client, error) {
+	if len(cfg.URLs) == 0 {
+		return nil, ErrNoEndpoint
+	}
+	if cfg.ReadQuorum > 1 && cfg.ReadQuorum >= len(cfg.URLs) {
+		return nil, fmt.Errorf("read quorum of %d needs at least %d endpoints, have %d", cfg.ReadQuorum, cfg.ReadQuorum+1, len(cfg.URLs))
+	}
+	if cfg.ProbeTimeout == 0 {
+		cfg.ProbeTimeout = 5 * time.Second
+	}
+
+	c := &Client{cfg: cfg}
+	var errs []error
+	for _, url := range cfg.URLs {
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", url, err))
+			continue
+		}
+		c.endpoints = append(c.endpoints, &endpoint{url: url, client: client, status: Status{URL: url}})
+	}
+	if len(c.endpoints) == 0 {
+		return nil, fmt.Errorf("%w: %v", ErrNoEndpoint, errors.Join(errs...))
+	}
+
+	c.CheckHealth(ctx)
+	return c, nil
+}
+
+// Closes every underlying connection
+func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, ep := range c.endpoints {
+		ep.client.Close()
+	}
+}
+
+// Probes every endpoint for its block height and latency, marks endpoints
+// that fail or lag more than MaxLag as unhealthy and reorders them so the
+// fastest healthy endpoint is tried first
+func (c *Client) CheckHealth(ctx context.Context) []Status {
+	c.mu.Lock()
+	endpoints := append([]*endpoint(nil), c.endpoints...)
+	c.mu.Unlock()
+
+	statuses := make([]Status, len(endpoints))
+	var wg sync.WaitGroup
+	for i, ep := range endpoints {
+		wg.Add(1)
+		go func(i int, ep *endpoint) {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, c.cfg.ProbeTimeout)
+			defer cancel()
+
+			start := time.Now()
+			height, err := ep.client.BlockNumber(probeCtx)
+			statuses[i] = Status{URL: ep.url, Height: height, Latency: time.Since(start), Healthy: err == nil, Err: err}
+		}(i, ep)
+	}
+	wg.Wait()
+
+	var best uint64
+	for _, s := range statuses {
+		if s.Healthy && s.Height > best {
+			best = s.Height
+		}
+	}
+	for i := range statuses {
+		if statuses[i].Healthy && best-statuses[i].Height > c.cfg.MaxLag {
+			statuses[i].Healthy = false
+			statuses[i].Err = fmt.Errorf("lagging %d blocks behind", best-statuses[i].Height)
+		}
+	}
+
+	c.mu.Lock()
+	for i, ep := range endpoints {
+		ep.status = statuses[i]
+	}
+	sort.SliceStable(c.endpoints, func(i, j int) bool {
+		a, b := c.endpoints[i].status, c.endpoints[j].status
+		if a.Healthy != b.Healthy {
+			return a.Healthy
+		}
+		return a.Healthy && a.Latency < b.Latency
+	})
+	c.mu.Unlock()
+
+	return statuses
+}
+
+// Asks every endpoint for its chain ID, failing if any serves another chain.
+// Endpoints that do not answer are dropped so the client never fails over to
+// a node whose chain was not checked.
+func (c *Client) VerifyChainID(ctx context.Context, want *big.Int) error {
+	c.mu.Lock()
+	endpoints := append([]*endpoint(nil), c.endpoints...)
+	c.mu.Unlock()
+
+	var verified []*endpoint
+	var errs []error
+	for _, ep := range endpoints {
+		id, err := ep.client.ChainID(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", ep.url, err))
+			continue
+		}
+		if id.Cmp(want) != 0 {
+			return fmt.Errorf("%s serves chain %s, want %s", ep.url, id, want)
+		}
+		verified = append(verified, ep)
+	}
+	if len(verified) == 0 {
+		return fmt.Errorf("%w: %v", ErrNoEndpoint, errors.Join(errs...))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var kept []*endpoint
+	for _, ep := range c.endpoints {
+		if slices.Contains(verified, ep) {
+			kept = append(kept, ep)
+		} else {
+			ep.client.Close()
+		}
+	}
+	c.endpoints = kept
+	return nil
+}
+
+// Runs CheckHealth every interval until the context is cancelled
+func (c *Client) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.CheckHealth(ctx)
+		}
+	}
+}
+
+// Current health of every endpoint, healthiest first
+func (c *Client) Statuses() []Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	statuses := make([]Status, len(c.endpoints))
+	for i, ep := range c.endpoints {
+		statuses[i] = ep.status
+	}
+	return statuses
+}
+
+// Healthy endpoints first, unhealthy ones kept as a last resort
+func (c *Client) ordered() []*endpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*endpoint(nil), c.endpoints...)
+}
+
+func (c *Client) markFailed(ep *endpoint, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ep.status.Healthy = false
+	ep.status.Err = err
+	for i, other := range c.endpoints {
+		if other == ep {
+			c.endpoints = append(append(c.endpoints[:i:i], c.endpoints[i+1:]...), ep)
+			break
+		}
+	}
+}
+
+// Whether an error says the endpoint is unusable rather than the request being
+// rejected: JSON-RPC errors, missing results and cancellations are final
+func retryable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	return true
+}
+
+func (c *Client) do(ctx context.Context, fn func(*ethclient.Client) error) error {
+	var errs []error
+	for _, ep := range c.ordered() {
+		err := fn(ep.client)
+		if !retryable(ctx, err) {
+			return err
+		}
+		c.markFailed(ep, err)
+		errs = append(errs, fmt.Errorf("%s: %v", ep.url, err))
+	}
+	return fmt.Errorf("%w: %v", ErrNoEndpoint, errors.Join(errs...))
+}
+
+// Runs a read on healthy endpoints pinned to the same block until ReadQuorum of
+// them answered, and checks the answers match. An endpoint that fails is marked
+// and the next healthy one takes its place.
+func (c *Client) agree(ctx context.Context, method string, blockNumber *big.Int, fn func(*ethclient.Client, *big.Int) ([]byte, error)) ([]byte, error) {
+	var healthy []*endpoint
+	c.mu.Lock()
+	for _, ep := range c.endpoints {
+		if ep.status.Healthy {
+			healthy = append(healthy, ep)
+		}
+	}
+	c.mu.Unlock()
+	if len(healthy) < c.cfg.ReadQuorum {
+		return nil, fmt.Errorf("%w: %s needs %d healthy endpoints, have %d", ErrNoEndpoint, method, c.cfg.ReadQuorum, len(healthy))
+	}
+
+	var errs []error
+	if blockNumber == nil {
+		// the heights of the last health check can be a whole Monitor interval
+		// old, so the latest block every endpoint has is asked for now
+		var answered []*endpoint
+		var lowest uint64
+		var ok bool
+		for _, ep := range healthy {
+			height, err := ep.client.BlockNumber(ctx)
+			if err != nil {
+				if !retryable(ctx, err) {
+					return nil, fmt.Errorf("%s: %v", ep.url, err)
+				}
+				c.markFailed(ep, err)
+				errs = append(errs, fmt.Errorf("%s: %v", ep.url, err))
+				continue
+			}
+			answered = append(answered, ep)
+			if !ok || height < lowest {
+				lowest, ok = height, true
+			}
+		}
+		if len(answered) < c.cfg.ReadQuorum {
+			return nil, fmt.Errorf("%w: %s got %d of %d heights: %v", ErrNoEndpoint, method, len(answered), c.cfg.ReadQuorum, errors.Join(errs...))
+		}
+		healthy = answered
+		blockNumber = new(big.Int).SetUint64(lowest)
+	}
+
+	var first []byte
+	var urls []string
+	for _, ep := range healthy {
+		result, err := fn(ep.client, blockNumber)
+		if err != nil {
+			if !retryable(ctx, err) {
+				return nil, fmt.Errorf("%s: %v", ep.url, err)
+			}
+			c.markFailed(ep, err)
+			errs = append(errs, fmt.Errorf("%s: %v", ep.url, err))
+			continue
+		}
+		urls = append(urls, ep.url)
+		if len(urls) == 1 {
+			first = result
+		} else if !bytes.Equal(first, result) {
+			return nil, &DisagreementError{Method: method, Block: blockNumber, URLs: urls}
+		}
+		if len(urls) == c.cfg.ReadQuorum {
+			return first, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s got %d of %d answers: %v", ErrNoEndpoint, method, len(urls), c.cfg.ReadQuorum, errors.Join(errs...))
+}
+
+func (c *Client) ChainID(ctx context.Context) (id *big.Int, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		id, err = client.ChainID(ctx)
+		return err
+	})
+	return id, err
+}
+
+func (c *Client) BlockNumber(ctx context.Context) (number uint64, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		number, err = client.BlockNumber(ctx)
+		return err
+	})
+	return number, err
+}
+
+func (c *Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		balance, err = client.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (c *Client) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	if c.cfg.ReadQuorum > 1 {
+		return c.agree(ctx, "eth_getCode", blockNumber, func(client *ethclient.Client, block *big.Int) ([]byte, error) {
+			return client.CodeAt(ctx, contract, block)
+		})
+	}
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		code, err = client.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (c *Client) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) (value []byte, err error) {
+	if c.cfg.ReadQuorum > 1 {
+		return c.agree(ctx, "eth_getStorageAt", blockNumber, func(client *ethclient.Client, block *big.Int) ([]byte, error) {
+			return client.StorageAt(ctx, account, key, block)
+		})
+	}
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		value, err = client.StorageAt(ctx, account, key, blockNumber)
+		return err
+	})
+	return value, err
+}
+
+func (c *Client) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	if c.cfg.ReadQuorum > 1 {
+		return c.agree(ctx, "eth_call", blockNumber, func(client *ethclient.Client, block *big.Int) ([]byte, error) {
+			return client.CallContract(ctx, call, block)
+		})
+	}
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		result, err = client.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		header, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (c *Client) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		code, err = client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+func (c *Client) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		nonce, err = client.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+func (c *Client) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		price, err = client.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (c *Client) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		tip, err = client.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tip, err
+}
+
+func (c *Client) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		gas, err = client.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// Sends the transaction, treating "already known" from a fallback endpoint as
+// success since the previous endpoint may have accepted it before failing
+func (c *Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return c.do(ctx, func(client *ethclient.Client) error {
+		err := client.SendTransaction(ctx, tx)
+		if err != nil && strings.Contains(err.Error(), "already known") {
+			return nil
+		}
+		return err
+	})
+}
+
+func (c *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		receipt, err = client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+func (c *Client) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		logs, err = client.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+func (c *Client) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	err = c.do(ctx, func(client *ethclient.Client) error {
+		sub, err = client.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return sub, err
+}
@@ -0,0 +1,220 @@
+package failover
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// JSON-RPC endpoint answering the few methods the tests use
+type fakeNode struct {
+	*httptest.Server
+	chainID uint64
+	height  atomic.Uint64
+	result  string
+	down    atomic.Bool
+	calls   atomic.Int32
+}
+
+func newFakeNode(t *testing.T, chainID, height uint64, result string) *fakeNode {
+	node := &fakeNode{chainID: chainID, result: result}
+	node.height.Store(height)
+	node.Server = httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func (n *fakeNode) serve(w http.ResponseWriter, r *http.Request) {
+	if n.down.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var result interface{}
+	switch req.Method {
+	case "eth_chainId":
+		result = hexutil.EncodeUint64(n.chainID)
+	case "eth_blockNumber":
+		result = hexutil.EncodeUint64(n.height.Load())
+	case "eth_call", "eth_getCode":
+		n.calls.Add(1)
+		result = n.result
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func dial(t *testing.T, quorum int, nodes ...*fakeNode) *Client {
+	t.Helper()
+	var urls []string
+	for _, node := range nodes {
+		urls = append(urls, node.URL)
+	}
+	client, err := Dial(context.Background(), Config{URLs: urls, MaxLag: 2, ReadQuorum: quorum})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+func call(client *Client) ([]byte, error) {
+	to := common.HexToAddress("0x1")
+	return client.CallContract(context.Background(), ethereum.CallMsg{To: &to}, nil)
+}
+
+func TestDialRequiresSpareEndpoint(t *testing.T) {
+	a, b := newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 1, 10, "0x01")
+	_, err := Dial(context.Background(), Config{URLs: []string{a.URL, b.URL}, ReadQuorum: 2})
+	if err == nil || !strings.Contains(err.Error(), "at least 3 endpoints") {
+		t.Fatalf("quorum of every endpoint accepted: %v", err)
+	}
+}
+
+func TestFailsOverToNextEndpoint(t *testing.T) {
+	primary, fallback := newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 1, 10, "0x01")
+	client := dial(t, 0, primary, fallback)
+	// the health check may have put either one first
+	if client.Statuses()[0].URL != primary.URL {
+		primary, fallback = fallback, primary
+	}
+
+	primary.down.Store(true)
+	number, err := client.BlockNumber(context.Background())
+	if err != nil || number != 10 {
+		t.Fatalf("block number = %d, %v", number, err)
+	}
+	statuses := client.Statuses()
+	if statuses[0].URL != fallback.URL || statuses[1].Healthy {
+		t.Errorf("failed endpoint not demoted: %+v", statuses)
+	}
+
+	fallback.down.Store(true)
+	if _, err := client.BlockNumber(context.Background()); !errors.Is(err, ErrNoEndpoint) {
+		t.Fatalf("error with every endpoint down = %v", err)
+	}
+}
+
+func TestHealthDemotesLaggingEndpoint(t *testing.T) {
+	lagging, current := newFakeNode(t, 1, 5, "0x01"), newFakeNode(t, 1, 10, "0x01")
+	client := dial(t, 0, lagging, current)
+
+	statuses := client.Statuses()
+	if statuses[0].URL != current.URL || statuses[1].Healthy || statuses[1].Err == nil {
+		t.Fatalf("lagging endpoint still preferred: %+v", statuses)
+	}
+}
+
+func TestVerifyChainIDChecksEveryEndpoint(t *testing.T) {
+	ctx := context.Background()
+	primary, other := newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 5, 10, "0x01")
+	client := dial(t, 0, primary, other)
+	err := client.VerifyChainID(ctx, big.NewInt(1))
+	if err == nil || !strings.Contains(err.Error(), other.URL) {
+		t.Fatalf("fallback on another chain accepted: %v", err)
+	}
+
+	// an endpoint that cannot be checked is dropped rather than trusted
+	down := newFakeNode(t, 5, 10, "0x01")
+	down.down.Store(true)
+	client = dial(t, 0, primary, down)
+	if err := client.VerifyChainID(ctx, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if statuses := client.Statuses(); len(statuses) != 1 || statuses[0].URL != primary.URL {
+		t.Fatalf("endpoints after the check: %+v", statuses)
+	}
+
+	client = dial(t, 0, down)
+	if err := client.VerifyChainID(ctx, big.NewInt(1)); !errors.Is(err, ErrNoEndpoint) {
+		t.Fatalf("no verified endpoint = %v", err)
+	}
+}
+
+func TestReadQuorum(t *testing.T) {
+	a, b, c := newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 1, 10, "0x01")
+	client := dial(t, 2, a, b, c)
+	result, err := call(client)
+	if err != nil || hexutil.Encode(result) != "0x01" {
+		t.Fatalf("call = %x, %v", result, err)
+	}
+	if calls := a.calls.Load() + b.calls.Load() + c.calls.Load(); calls != 2 {
+		t.Errorf("%d endpoints asked, want the quorum of 2", calls)
+	}
+
+	// one endpoint failing mid-read is replaced by the spare
+	statuses := client.Statuses()
+	for _, node := range []*fakeNode{a, b, c} {
+		if node.URL == statuses[0].URL {
+			node.down.Store(true)
+		}
+	}
+	if _, err := call(client); err != nil {
+		t.Fatalf("call with one endpoint down: %v", err)
+	}
+	if _, err := call(client); err != nil {
+		t.Fatalf("call after the failure was recorded: %v", err)
+	}
+
+	// a second failure leaves fewer healthy endpoints than the quorum
+	for _, node := range []*fakeNode{a, b, c} {
+		if !node.down.Load() {
+			node.down.Store(true)
+			break
+		}
+	}
+	if _, err := call(client); !errors.Is(err, ErrNoEndpoint) {
+		t.Fatalf("call below quorum = %v", err)
+	}
+}
+
+func TestReadQuorumDisagreement(t *testing.T) {
+	a, b, c := newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 1, 10, "0x02"), newFakeNode(t, 1, 10, "0x01")
+	client := dial(t, 2, a, b, c)
+	// with c down only a and b remain to be compared
+	c.down.Store(true)
+	client.CheckHealth(context.Background())
+
+	_, err := call(client)
+	var disagreement *DisagreementError
+	if !errors.As(err, &disagreement) || disagreement.Method != "eth_call" || disagreement.Block.Uint64() != 10 {
+		t.Fatalf("error = %v", err)
+	}
+}
+
+// Reads are pinned to the lowest height the endpoints report when the read is
+// made, not to the heights of the last health check
+func TestReadQuorumPinsCurrentHeight(t *testing.T) {
+	a, b, c := newFakeNode(t, 1, 10, "0x01"), newFakeNode(t, 1, 10, "0x02"), newFakeNode(t, 1, 10, "0x01")
+	client := dial(t, 2, a, b, c)
+	c.down.Store(true)
+	client.CheckHealth(context.Background())
+
+	a.height.Store(20)
+	b.height.Store(21)
+	_, err := call(client)
+	var disagreement *DisagreementError
+	if !errors.As(err, &disagreement) || disagreement.Block.Uint64() != 20 {
+		t.Fatalf("error = %v, want a disagreement at block 20", err)
+	}
+}
@@ -0,0 +1,83 @@
+package json
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Returned in offline mode when the URL was never downloaded
+var ErrNotCached = errors.New("response not cached")
+
+// Stored response for one URL
+type CacheEntry struct {
+	URL          string               `json:"url"`
+	ETag         string               `json:"etag,omitempty"`
+	LastModified string               `json:"lastModified,omitempty"`
+	FetchedAt    time.Time            `json:"fetchedAt"`
+	FirstSeen    map[string]time.Time `json:"firstSeen,omitempty"` // when each date first appeared
+	Body         json.RawMessage      `json:"body"`
+}
+
+// On-disk store of the last successful response per URL
+type Cache struct {
+	Dir string
+}
+
+func NewCache(dir string) *Cache {
+	return &Cache{Dir: dir}
+}
+
+func (c *Cache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:8])+".json")
+}
+
+// Returns the cached entry for a URL, or ErrNotCached
+func (c *Cache) Load(url string) (*CacheEntry, error) {
+	body, err := os.ReadFile(c.path(url))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", url, ErrNotCached)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return nil, fmt.Errorf("corrupt cache entry for %s: %v", url, err)
+	}
+	return &entry, nil
+}
+
+// Writes the entry atomically so an interrupted run never leaves a partial file
+func (c *Cache) Store(entry *CacheEntry) error {
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(c.Dir, ".entry-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(entry.URL))
+}
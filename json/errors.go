@@ -0,0 +1,46 @@
+package json
+
+import (
+	"fmt"
+)
+
+// Request never got an HTTP response (DNS, connection, timeout)
+type NetworkError struct {
+	URL string
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("request to %s failed: %v", e.URL, e.Err)
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// Server answered with a non-2xx status
+type HTTPError struct {
+	URL        string
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("request to %s returned %s", e.URL, e.Status)
+}
+
+// Response body is not the expected JSON series
+type PayloadError struct {
+	URL         string
+	ContentType string
+	Err         error
+}
+
+func (e *PayloadError) Error() string {
+	return fmt.Sprintf("malformed payload from %s (%s): %v", e.URL, e.ContentType, e.Err)
+}
+
+func (e *PayloadError) Unwrap() error {
+	return e.Err
+}
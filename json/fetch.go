@@ -1,34 +1,302 @@
 package json
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // JSON structure from the API
 type Data struct {
 	Data  string `json:"data"`
 	Valor string `json:"valor"`
+	// Publication time of the first response that contained the entry, as far
+	// as the cache remembers; not part of the API payload
+	PublishedAt time.Time `json:"-"`
+}
+
+// Downloaded series with the time the data is known to be current.
+// PublishedAt comes from the server's Last-Modified header and is zero when
+// the server does not send one; RetrievedAt is when the response was received.
+type Series struct {
+	Data        []Data
+	PublishedAt time.Time
+	RetrievedAt time.Time
+}
+
+// Best known timestamp of the data: the publication time when available,
+// otherwise the retrieval time
+func (s *Series) UpdatedAt() time.Time {
+	if !s.PublishedAt.IsZero() {
+		return s.PublishedAt
+	}
+	return s.RetrievedAt
+}
+
+// Bytes of an error response kept in HTTPError
+const maxErrorBody = 512
+
+// HTTP client settings for downloading a series.
+// With a Cache, requests are conditional on the stored ETag/Last-Modified;
+// Offline replays the cached response without touching the network.
+// A Retry-After longer than MaxRetryAfter (MaxDelay when zero) fails the fetch.
+// Logger receives the warnings that do not fail a fetch; nil uses slog.Default().
+type Fetcher struct {
+	Client        *http.Client
+	UserAgent     string
+	MaxRetries    int
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+	MaxRetryAfter time.Duration
+	Cache         *Cache
+	Offline       bool
+	Logger        *slog.Logger
+}
+
+// Fetcher with a request timeout and exponential backoff
+func NewFetcher() *Fetcher {
+	return &Fetcher{
+		Client:        &http.Client{Timeout: 30 * time.Second},
+		UserAgent:     "go-abigen/1.0",
+		MaxRetries:    4,
+		BaseDelay:     time.Second,
+		MaxDelay:      time.Minute,
+		MaxRetryAfter: 5 * time.Minute,
+	}
 }
 
 // JSON data from a URL and returns an array of Data
 func FetchData(url string) ([]Data, error) {
-	resp, err := http.Get(url)
+	return NewFetcher().Fetch(context.Background(), url)
+}
+
+// Downloads and decodes a series, retrying network failures, 429 and 5xx responses
+func (f *Fetcher) Fetch(ctx context.Context, url string) ([]Data, error) {
+	series, err := f.FetchSeries(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	return series.Data, nil
+}
+
+// Like Fetch, also returning when the data was published and retrieved
+func (f *Fetcher) FetchSeries(ctx context.Context, url string) (*Series, error) {
+	if f.Offline {
+		return f.replay(url)
+	}
+
+	for attempt := 0; ; attempt++ {
+		series, retryAfter, err := f.fetchOnce(ctx, url)
+		if err == nil {
+			return series, nil
+		}
+		if !retryable(err) || attempt >= f.MaxRetries {
+			return nil, err
+		}
+
+		// the server's Retry-After is honored in full; when it outlasts the
+		// context or MaxRetryAfter there is no point waiting, so the error is returned now
+		delay := f.backoff(attempt)
+		if retryAfter > 0 {
+			limit := f.MaxRetryAfter
+			if limit <= 0 {
+				limit = f.MaxDelay
+			}
+			if retryAfter > limit {
+				return nil, err
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Now().Add(retryAfter).After(deadline) {
+				return nil, err
+			}
+			delay = retryAfter
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, &NetworkError{URL: url, Err: ctx.Err()}
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (f *Fetcher) fetchOnce(ctx context.Context, url string) (*Series, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if f.UserAgent != "" {
+		req.Header.Set("User-Agent", f.UserAgent)
+	}
+
+	var cached *CacheEntry
+	if f.Cache != nil {
+		cached, _ = f.Cache.Load(url)
+	}
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, &NetworkError{URL: url, Err: err}
+	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		data, err := decode(url, "cache", cached.Body)
+		if err != nil {
+			return nil, 0, err
+		}
+		return newSeries(data, cached.LastModified, time.Now().UTC(), cached.FirstSeen), 0, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		httpErr := &HTTPError{URL: url, StatusCode: resp.StatusCode, Status: resp.Status, Body: string(body)}
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), httpErr
+	}
+
 	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, &NetworkError{URL: url, Err: err}
+	}
+
+	data, err := decode(url, resp.Header.Get("Content-Type"), body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var firstSeen map[string]time.Time
+	if cached != nil {
+		firstSeen = cached.FirstSeen
+	}
+	series := newSeries(data, resp.Header.Get("Last-Modified"), time.Now().UTC(), firstSeen)
+
+	if f.Cache != nil {
+		entry := &CacheEntry{
+			URL:          url,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+			FetchedAt:    series.RetrievedAt,
+			FirstSeen:    series.firstSeen(),
+			Body:         body,
+		}
+		// the download succeeded; a cache that cannot be written only costs
+		// the next run a conditional request
+		if err := f.Cache.Store(entry); err != nil {
+			f.logger().Warn("Failed to cache response", "url", url, "err", err)
+		}
+	}
+
+	return series, 0, nil
+}
+
+// Decodes the cached response for offline runs
+func (f *Fetcher) replay(url string) (*Series, error) {
+	if f.Cache == nil {
+		return nil, fmt.Errorf("offline mode requires a cache: %w", ErrNotCached)
+	}
+	entry, err := f.Cache.Load(url)
 	if err != nil {
 		return nil, err
 	}
+	data, err := decode(url, "cache", entry.Body)
+	if err != nil {
+		return nil, err
+	}
+	return newSeries(data, entry.LastModified, entry.FetchedAt, entry.FirstSeen), nil
+}
+
+// Stamps each entry with the time it was first seen, or with the series'
+// UpdatedAt when it is new
+func newSeries(data []Data, lastModified string, retrievedAt time.Time, firstSeen map[string]time.Time) *Series {
+	series := &Series{Data: data, RetrievedAt: retrievedAt}
+	if published, err := http.ParseTime(lastModified); err == nil {
+		series.PublishedAt = published.UTC()
+	}
+	for i := range data {
+		if seen, ok := firstSeen[data[i].Data]; ok {
+			data[i].PublishedAt = seen
+		} else {
+			data[i].PublishedAt = series.UpdatedAt()
+		}
+	}
+	return series
+}
 
+// Publication time of every entry by date, kept in the cache for the next run
+func (s *Series) firstSeen() map[string]time.Time {
+	seen := make(map[string]time.Time, len(s.Data))
+	for _, entry := range s.Data {
+		seen[entry.Data] = entry.PublishedAt
+	}
+	return seen
+}
+
+func decode(url, contentType string, body []byte) ([]Data, error) {
 	var data []Data
 	if err := json.Unmarshal(body, &data); err != nil {
-		return nil, err
+		return nil, &PayloadError{URL: url, ContentType: contentType, Err: err}
 	}
-
 	return data, nil
 }
+
+func (f *Fetcher) logger() *slog.Logger {
+	if f.Logger != nil {
+		return f.Logger
+	}
+	return slog.Default()
+}
+
+func (f *Fetcher) backoff(attempt int) time.Duration {
+	delay := f.BaseDelay << attempt
+	if delay <= 0 || delay > f.MaxDelay {
+		return f.MaxDelay
+	}
+	return delay
+}
+
+func retryable(err error) bool {
+	var netErr *NetworkError
+	if errors.As(err, &netErr) {
+		return !errors.Is(netErr.Err, context.Canceled)
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+	return false
+}
+
+// Retry-After is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(at); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
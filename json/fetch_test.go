@@ -0,0 +1,336 @@
+package json_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"abi/bcbtest"
+	source "abi/json"
+)
+
+func newFetcher() *source.Fetcher {
+	fetcher := source.NewFetcher()
+	fetcher.BaseDelay = time.Millisecond
+	fetcher.MaxDelay = 10 * time.Millisecond
+	return fetcher
+}
+
+func TestFetchWindow(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+	server.LastModified = time.Date(2024, 3, 1, 21, 0, 0, 0, time.UTC)
+
+	start := time.Date(2024, 2, 8, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 16, 0, 0, 0, 0, time.UTC)
+	series, err := newFetcher().FetchSeries(context.Background(), server.WindowURL(12, start, end))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 12 and 13/02 are Carnival
+	want := []string{"08/02/2024", "09/02/2024", "14/02/2024", "15/02/2024", "16/02/2024"}
+	if len(series.Data) != len(want) {
+		t.Fatalf("got %d entries, want %d: %v", len(series.Data), len(want), series.Data)
+	}
+	for i, date := range want {
+		if series.Data[i].Data != date {
+			t.Errorf("entry %d is %s, want %s", i, series.Data[i].Data, date)
+		}
+	}
+	if !series.PublishedAt.Equal(server.LastModified) {
+		t.Errorf("PublishedAt = %v, want %v", series.PublishedAt, server.LastModified)
+	}
+}
+
+func TestFetchLast(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+
+	data, err := newFetcher().Fetch(context.Background(), server.LastURL(11, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 3 || data[2].Data != "28/03/2024" {
+		t.Fatalf("ultimos/3 = %v", data)
+	}
+
+	_, err = newFetcher().Fetch(context.Background(), server.LastURL(11, 21))
+	var httpErr *source.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("ultimos/21 error = %v", err)
+	}
+}
+
+func TestFetchUnknownSeries(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+
+	_, err := newFetcher().Fetch(context.Background(), server.SeriesURL(99999))
+	var httpErr *source.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("error = %v", err)
+	}
+	if server.Requests() != 1 {
+		t.Errorf("404 was retried: %d requests", server.Requests())
+	}
+}
+
+func TestFetchFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures []bcbtest.Failure
+		always   bool
+		requests int
+		check    func(error) bool
+	}{
+		{
+			name:     "server error recovers",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultServerError}, {Fault: bcbtest.FaultServerError}},
+			requests: 3,
+			check:    func(err error) bool { return err == nil },
+		},
+		{
+			name:     "rate limit recovers",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultRateLimited, RetryAfter: 0}},
+			requests: 2,
+			check:    func(err error) bool { return err == nil },
+		},
+		{
+			name:     "server error exhausts retries",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultServerError}},
+			always:   true,
+			requests: 5,
+			check: func(err error) bool {
+				var httpErr *source.HTTPError
+				return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusInternalServerError
+			},
+		},
+		{
+			name:     "html page",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultHTML}},
+			requests: 1,
+			check: func(err error) bool {
+				var payloadErr *source.PayloadError
+				return errors.As(err, &payloadErr) && payloadErr.ContentType == "text/html; charset=utf-8"
+			},
+		},
+		{
+			name:     "malformed json",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultMalformed}},
+			requests: 1,
+			check: func(err error) bool {
+				var payloadErr *source.PayloadError
+				return errors.As(err, &payloadErr)
+			},
+		},
+		{
+			name:     "truncated body recovers",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultTruncated}},
+			requests: 2,
+			check:    func(err error) bool { return err == nil },
+		},
+		{
+			name:     "truncated body exhausts retries",
+			failures: []bcbtest.Failure{{Fault: bcbtest.FaultTruncated}},
+			always:   true,
+			requests: 5,
+			check: func(err error) bool {
+				var netErr *source.NetworkError
+				return errors.As(err, &netErr)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := bcbtest.NewServer()
+			defer server.Close()
+			if tt.always {
+				server.FailAlways(tt.failures[0])
+			} else {
+				server.Fail(tt.failures...)
+			}
+
+			data, err := newFetcher().Fetch(context.Background(), server.SeriesURL(12))
+			if !tt.check(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil && len(data) == 0 {
+				t.Error("no data after recovery")
+			}
+			if server.Requests() != tt.requests {
+				t.Errorf("%d requests, want %d", server.Requests(), tt.requests)
+			}
+		})
+	}
+}
+
+func TestFetchRetryAfter(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+	server.Fail(bcbtest.Failure{Fault: bcbtest.FaultRateLimited, RetryAfter: 1})
+
+	// waits the full second even though MaxDelay is shorter
+	start := time.Now()
+	if _, err := newFetcher().Fetch(context.Background(), server.SeriesURL(12)); err != nil {
+		t.Fatal(err)
+	}
+	if waited := time.Since(start); waited < time.Second {
+		t.Errorf("retried after %v, Retry-After was 1s", waited)
+	}
+
+	// a Retry-After past the deadline fails without waiting
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	server.Fail(bcbtest.Failure{Fault: bcbtest.FaultRateLimited, RetryAfter: 30})
+	start = time.Now()
+	_, err := newFetcher().Fetch(ctx, server.SeriesURL(12))
+	var httpErr *source.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("error = %v", err)
+	}
+	if time.Since(start) > time.Second || server.Requests() != 3 {
+		t.Errorf("gave up after %v and %d requests", time.Since(start), server.Requests())
+	}
+
+	// without a deadline, one past MaxRetryAfter fails without waiting too
+	fetcher := newFetcher()
+	fetcher.MaxRetryAfter = 2 * time.Second
+	server.Fail(bcbtest.Failure{Fault: bcbtest.FaultRateLimited, RetryAfter: 30})
+	start = time.Now()
+	_, err = fetcher.Fetch(context.Background(), server.SeriesURL(12))
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("error = %v", err)
+	}
+	if time.Since(start) > time.Second || server.Requests() != 4 {
+		t.Errorf("gave up after %v and %d requests", time.Since(start), server.Requests())
+	}
+}
+
+func TestFetchSlowResponse(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+	server.Fail(bcbtest.Failure{Fault: bcbtest.FaultSlow, Delay: time.Second})
+
+	fetcher := newFetcher()
+	fetcher.Client = &http.Client{Timeout: 50 * time.Millisecond}
+	data, err := fetcher.Fetch(context.Background(), server.SeriesURL(12))
+	if err != nil {
+		t.Fatalf("slow response was not retried: %v", err)
+	}
+	if len(data) == 0 || server.Requests() != 2 {
+		t.Errorf("%d entries after %d requests", len(data), server.Requests())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	server.FailAlways(bcbtest.Failure{Fault: bcbtest.FaultSlow, Delay: time.Second})
+	_, err = newFetcher().Fetch(ctx, server.SeriesURL(12))
+	var netErr *source.NetworkError
+	if !errors.As(err, &netErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v", err)
+	}
+}
+
+func TestPublicationTimePerEntry(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+	first := time.Date(2024, 3, 1, 21, 0, 0, 0, time.UTC)
+	server.LastModified = first
+	server.SetSeries(12, []source.Data{{Data: "01/03/2024", Valor: "0.043739"}})
+
+	fetcher := newFetcher()
+	fetcher.Cache = source.NewCache(t.TempDir())
+	if _, err := fetcher.FetchSeries(context.Background(), server.SeriesURL(12)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the next day is published later; the earlier day keeps its own time
+	second := first.Add(24 * time.Hour)
+	server.LastModified = second
+	server.SetSeries(12, []source.Data{{Data: "01/03/2024", Valor: "0.043739"}, {Data: "04/03/2024", Valor: "0.043739"}})
+	series, err := fetcher.FetchSeries(context.Background(), server.SeriesURL(12))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series.Data) != 2 || !series.Data[0].PublishedAt.Equal(first) || !series.Data[1].PublishedAt.Equal(second) {
+		t.Fatalf("publication times %v", series.Data)
+	}
+
+	fetcher.Offline = true
+	replayed, err := fetcher.FetchSeries(context.Background(), server.SeriesURL(12))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !replayed.Data[0].PublishedAt.Equal(first) || !replayed.Data[1].PublishedAt.Equal(second) {
+		t.Fatalf("replayed publication times %v", replayed.Data)
+	}
+}
+
+func TestOfflineReplay(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+	cache := source.NewCache(t.TempDir())
+
+	fetcher := newFetcher()
+	fetcher.Cache = cache
+	online, err := fetcher.Fetch(context.Background(), server.SeriesURL(12))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.FailAlways(bcbtest.Failure{Fault: bcbtest.FaultServerError})
+	fetcher.Offline = true
+	offline, err := fetcher.Fetch(context.Background(), server.SeriesURL(12))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(offline) != len(online) || server.Requests() != 1 {
+		t.Errorf("replayed %d of %d entries after %d requests", len(offline), len(online), server.Requests())
+	}
+}
+
+func TestFetchUnwritableCache(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+
+	// a regular file where the cache directory should be
+	dir := filepath.Join(t.TempDir(), "cache")
+	if err := os.WriteFile(dir, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	fetcher := newFetcher()
+	fetcher.Cache = source.NewCache(dir)
+	data, err := fetcher.Fetch(context.Background(), server.SeriesURL(12))
+	if err != nil || len(data) == 0 {
+		t.Fatalf("fetch with a broken cache = %d entries, %v", len(data), err)
+	}
+}
+
+func TestFetchLogsCacheFailure(t *testing.T) {
+	server := bcbtest.NewServer()
+	defer server.Close()
+
+	// a file where the cache directory should be makes every store fail
+	blocked := filepath.Join(t.TempDir(), "cache")
+	if err := os.WriteFile(blocked, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	var logs bytes.Buffer
+	fetcher := newFetcher()
+	fetcher.Cache = source.NewCache(blocked)
+	fetcher.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+
+	if _, err := fetcher.Fetch(context.Background(), server.SeriesURL(12)); err != nil {
+		t.Fatalf("a cache failure failed the fetch: %v", err)
+	}
+	if !strings.Contains(logs.String(), "Failed to cache response") {
+		t.Errorf("logs = %q", logs.String())
+	}
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"abi/json"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	FetchDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "oracle_fetch_duration_seconds",
+		Help:    "Time to download the BCB series.",
+		Buckets: prometheus.ExponentialBuckets(0.25, 2, 10),
+	})
+	FetchErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oracle_fetch_errors_total",
+		Help: "Failed BCB downloads by kind (network, http, payload, other).",
+	}, []string{"kind"})
+	ObservationsParsed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "oracle_observations_parsed_total",
+		Help: "Observations that passed validation.",
+	})
+	ObservationsRejected = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "oracle_observations_rejected_total",
+		Help: "Observations rejected by validation.",
+	})
+	Transactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oracle_transactions_total",
+		Help: "saveIndicator transactions by status (sent, confirmed, reverted, failed).",
+	}, []string{"network", "status"})
+	GasUsed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oracle_gas_used_total",
+		Help: "Gas used by confirmed transactions.",
+	}, []string{"network"})
+	FeeSpent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oracle_fee_spent_wei_total",
+		Help: "Transaction fees paid by the signer, in wei.",
+	}, []string{"network"})
+	Nonce = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_signer_nonce",
+		Help: "Pending nonce of the signer.",
+	}, []string{"network"})
+	Balance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_signer_balance_wei",
+		Help: "Balance of the signer, in wei.",
+	}, []string{"network"})
+	LastValueAge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_last_value_age_seconds",
+		Help: "Seconds since the updatedat of the last on-chain value.",
+	}, []string{"network", "series"})
+)
+
+func init() {
+	prometheus.MustRegister(FetchDuration, FetchErrors, ObservationsParsed, ObservationsRejected,
+		Transactions, GasUsed, FeeSpent, Nonce, Balance, LastValueAge)
+}
+
+// Label for a fetch error according to the json package's typed errors
+func FetchErrorKind(err error) string {
+	var netErr *json.NetworkError
+	var httpErr *json.HTTPError
+	var payloadErr *json.PayloadError
+	switch {
+	case errors.As(err, &netErr):
+		return "network"
+	case errors.As(err, &httpErr):
+		return "http"
+	case errors.As(err, &payloadErr):
+		return "payload"
+	}
+	return "other"
+}
+
+// Serves /metrics on addr in the background
+func Serve(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Printf("Metrics server stopped: %v", err)
+		}
+	}()
+}
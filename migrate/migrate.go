@@ -0,0 +1,407 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"abi/api"
+	"abi/datekey"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Node access needed to write to the target contract
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Stored day as read from a contract
+type Day struct {
+	Key        datekey.Key
+	Value      *big.Int
+	UpdatedAt  *big.Int
+	Confidence uint8
+	Retracted  bool
+}
+
+// Day whose copy on the target does not match the source
+type Mismatch struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+// Outcome of one migration
+type Report struct {
+	Source            common.Address `json:"source"`
+	Target            common.Address `json:"target"`
+	SourceDecimals    uint8          `json:"sourceDecimals"`
+	TargetDecimals    uint8          `json:"targetDecimals"`
+	Rescaled          bool           `json:"rescaled"`
+	Read              int            `json:"read"`
+	SourceCheckpoints *uint64        `json:"sourceCheckpoints,omitempty"` // nil for contracts without checkpoints
+	Skipped           int            `json:"skipped"`                     // already present on the target
+	Written           int            `json:"written"`
+	Verified          int            `json:"verified"`
+	Truncated         []string       `json:"truncated,omitempty"`  // dates whose value lost digits when rescaled
+	Retracted         []string       `json:"retracted,omitempty"`  // retracted on the source and not copied
+	OutOfOrder        []string       `json:"outOfOrder,omitempty"` // before the target's last day, written as backfills
+	DryRun            bool           `json:"dryRun,omitempty"`
+	Mismatches        []Mismatch     `json:"mismatches,omitempty"`
+	Err               string         `json:"error,omitempty"`
+}
+
+// Fails when the copy is incomplete or differs from the source
+func (r *Report) Check() error {
+	switch {
+	case r.Err != "":
+		return fmt.Errorf("migration stopped: %s", r.Err)
+	case len(r.Mismatches) > 0:
+		return fmt.Errorf("%d of %d days differ on the target", len(r.Mismatches), r.Read)
+	case r.SourceCheckpoints != nil && *r.SourceCheckpoints != uint64(r.Read):
+		return fmt.Errorf("read %d days but the source has %d checkpoints; widen the date range", r.Read, *r.SourceCheckpoints)
+	}
+	return nil
+}
+
+// Human readable summary followed by every problem
+func (r *Report) Write(w io.Writer) {
+	fmt.Fprintf(w, "Migration %s (%d decimals) -> %s (%d decimals)\n", r.Source.Hex(), r.SourceDecimals, r.Target.Hex(), r.TargetDecimals)
+	fmt.Fprintf(w, "  read %d days", r.Read)
+	if r.SourceCheckpoints != nil {
+		fmt.Fprintf(w, " (source checkpoints: %d)", *r.SourceCheckpoints)
+	}
+	fmt.Fprintf(w, ", skipped %d, written %d, verified %d\n", r.Skipped, r.Written, r.Verified)
+	if r.DryRun {
+		fmt.Fprintf(w, "  dry run, nothing sent: %d days to write\n", r.Read-len(r.Retracted)-r.Skipped)
+	}
+	if r.Rescaled {
+		fmt.Fprintf(w, "  values rescaled, %d truncated\n", len(r.Truncated))
+	}
+	for _, date := range r.Truncated {
+		fmt.Fprintf(w, "  %s: value truncated by rescaling\n", date)
+	}
+	for _, date := range r.Retracted {
+		fmt.Fprintf(w, "  %s: retracted on the source, not copied\n", date)
+	}
+	for _, date := range r.OutOfOrder {
+		fmt.Fprintf(w, "  %s: before the target's last day, written as a backfill\n", date)
+	}
+	for _, mismatch := range r.Mismatches {
+		fmt.Fprintf(w, "  %s: %s\n", mismatch.Date, mismatch.Reason)
+	}
+	if r.Err != "" {
+		fmt.Fprintf(w, "  error: %s\n", r.Err)
+	}
+}
+
+// Machine readable report
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// What to copy and how
+type Options struct {
+	Start, End datekey.Key // inclusive range of days scanned on the source
+	Rescale    bool        // convert values to the target decimals instead of refusing to copy
+	BatchSize  int         // transactions sent before waiting for receipts
+	DryRun     bool        // report what would be written without sending anything
+}
+
+// Copies the stored days from source to target and verifies the result.
+// Days retracted on the source are left out. On targets with limits the days
+// are written through overrideIndicator, which needs DEFAULT_ADMIN_ROLE.
+// The report is always returned; Report.Check tells whether the copy is complete.
+func Run(ctx context.Context, backend Backend, auth *bind.TransactOpts, source, target common.Address, opts Options) *Report {
+	report := &Report{Source: source, Target: target}
+	fail := func(err error) *Report {
+		report.Err = err.Error()
+		return report
+	}
+
+	from, err := api.NewOracleIndicatorCaller(source, backend)
+	if err != nil {
+		return fail(err)
+	}
+	to, err := api.NewOracleIndicator(target, backend)
+	if err != nil {
+		return fail(err)
+	}
+	// getDay needs a reader or operator role on private deployments
+	call := &bind.CallOpts{Context: ctx, From: auth.From}
+	if report.SourceDecimals, err = from.Decimal(call); err != nil {
+		return fail(fmt.Errorf("failed to read source decimals: %v", err))
+	}
+	if report.TargetDecimals, err = to.Decimal(call); err != nil {
+		return fail(fmt.Errorf("failed to read target decimals: %v", err))
+	}
+	if report.SourceDecimals != report.TargetDecimals && !opts.Rescale {
+		return fail(fmt.Errorf("source has %d decimals and target %d; rescaling is required", report.SourceDecimals, report.TargetDecimals))
+	}
+	report.Rescaled = report.SourceDecimals != report.TargetDecimals
+	override, err := Overridable(call, &to.OracleIndicatorCaller)
+	if err != nil {
+		return fail(err)
+	}
+	if override {
+		admin, err := to.HasRole(call, [32]byte{}, auth.From)
+		if err != nil {
+			return fail(fmt.Errorf("failed to read the signer's role on the target: %v", err))
+		}
+		if !admin {
+			return fail(fmt.Errorf("%s lacks DEFAULT_ADMIN_ROLE on the target, which overrideIndicator needs", auth.From.Hex()))
+		}
+	}
+
+	read, err := Read(call, backend, source, opts.Start, opts.End)
+	if err != nil {
+		return fail(err)
+	}
+	report.Read = len(read)
+	report.SourceCheckpoints = Checkpoints(ctx, from)
+	var days []Day
+	for _, day := range read {
+		if day.Retracted {
+			report.Retracted = append(report.Retracted, day.Key.String())
+			continue
+		}
+		days = append(days, day)
+	}
+
+	days, report.Truncated = Rescale(days, report.SourceDecimals, report.TargetDecimals)
+	pending, err := Pending(call, backend, target, days)
+	if err != nil {
+		return fail(err)
+	}
+	report.Skipped = len(days) - len(pending)
+	if report.OutOfOrder, err = OutOfOrder(call, &to.OracleIndicatorCaller, pending); err != nil {
+		return fail(err)
+	}
+	if opts.DryRun {
+		report.DryRun = true
+		return report
+	}
+
+	report.Written, err = Write(ctx, backend, auth, &to.OracleIndicatorTransactor, pending, opts.BatchSize, override)
+	if err != nil {
+		report.Err = err.Error()
+	}
+	if err := Verify(call, backend, target, days, report); err != nil && report.Err == "" {
+		report.Err = err.Error()
+	}
+	return report
+}
+
+// Reads every stored day between start and end, retracted ones included
+func Read(opts *bind.CallOpts, backend bind.ContractCaller, source common.Address, start, end datekey.Key) ([]Day, error) {
+	var days []Day
+	for key := start; key <= end; key = key.Next() {
+		stored, retracted, err := api.ReadDay(opts, backend, source, key.Timestamp())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", key, err)
+		}
+		if stored.Updatedat.Sign() == 0 {
+			continue
+		}
+		days = append(days, Day{Key: key, Value: stored.Value, UpdatedAt: stored.Updatedat, Confidence: stored.Confidence, Retracted: retracted})
+	}
+	return days, nil
+}
+
+// Number of checkpointed days on the source, nil for contracts deployed
+// before checkpoints existed
+func Checkpoints(ctx context.Context, source *api.OracleIndicatorCaller) *uint64 {
+	count, err := source.CheckpointCount(&bind.CallOpts{Context: ctx})
+	if err != nil || !count.IsUint64() {
+		return nil
+	}
+	n := count.Uint64()
+	return &n
+}
+
+// Converts values from one number of decimals to another. Dropping decimals
+// truncates toward zero; the affected dates are returned.
+func Rescale(days []Day, from, to uint8) ([]Day, []string) {
+	if from == to {
+		return days, nil
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(int(to)-int(from)))), nil)
+
+	var truncated []string
+	result := make([]Day, len(days))
+	for i, day := range days {
+		value := new(big.Int)
+		if to > from {
+			value.Mul(day.Value, factor)
+		} else {
+			var remainder big.Int
+			value.QuoRem(day.Value, factor, &remainder)
+			if remainder.Sign() != 0 {
+				truncated = append(truncated, day.Key.String())
+			}
+		}
+		day.Value = value
+		result[i] = day
+	}
+	return result, truncated
+}
+
+// Drops days the target already holds unchanged, so an interrupted
+// migration can be rerun
+func Pending(opts *bind.CallOpts, backend bind.ContractCaller, target common.Address, days []Day) ([]Day, error) {
+	var result []Day
+	for _, day := range days {
+		stored, _, err := api.ReadDay(opts, backend, target, day.Key.Timestamp())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from the target: %v", day.Key, err)
+		}
+		if stored.Updatedat.Cmp(day.UpdatedAt) == 0 && stored.Value.Cmp(day.Value) == 0 && stored.Confidence == day.Confidence {
+			continue
+		}
+		result = append(result, day)
+	}
+	return result, nil
+}
+
+// Whether target has overrideIndicator, which writes past its limits and
+// consensus rounds; deployments that predate limits only have saveIndicator
+func Overridable(opts *bind.CallOpts, target *api.OracleIndicatorCaller) (bool, error) {
+	if _, err := target.MaxDeviationBps(opts); err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read the target's limits: %v", err)
+	}
+	return true, nil
+}
+
+// Dates of the days older than the target's last stored day. They are
+// written as backfills behind it and change the intervals that cover them.
+// Deployments without lastDay report none.
+func OutOfOrder(opts *bind.CallOpts, target *api.OracleIndicatorCaller, days []Day) ([]string, error) {
+	last, err := target.LastDay(opts)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the target's last day: %v", err)
+	}
+	var dates []string
+	for _, day := range days {
+		if last.Sign() != 0 && day.Key.Timestamp().Cmp(last) < 0 {
+			dates = append(dates, day.Key.String())
+		}
+	}
+	return dates, nil
+}
+
+// Sends the days in order, batchSize transactions at a time with consecutive
+// nonces, waiting for each batch to be mined before sending the next. With
+// override the days go through overrideIndicator instead of saveIndicator.
+// Returns how many were mined successfully before the first failure.
+func Write(ctx context.Context, backend Backend, auth *bind.TransactOpts, target *api.OracleIndicatorTransactor, days []Day, batchSize int, override bool) (int, error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	nonce, err := backend.PendingNonceAt(ctx, auth.From)
+	if err != nil {
+		return 0, err
+	}
+
+	written := 0
+	for start := 0; start < len(days); start += batchSize {
+		batch := days[start:min(start+batchSize, len(days))]
+
+		var sent []*types.Transaction
+		for _, day := range batch {
+			opts := *auth
+			opts.Context = ctx
+			opts.Nonce = new(big.Int).SetUint64(nonce)
+			var tx *types.Transaction
+			if override {
+				tx, err = target.OverrideIndicator(&opts, day.Key.Timestamp(), day.Value, day.UpdatedAt, day.Confidence)
+			} else {
+				tx, err = target.SaveIndicator(&opts, day.Key.Timestamp(), day.Value, day.UpdatedAt, day.Confidence)
+			}
+			if err != nil {
+				err = api.DecodeError(err)
+				if len(sent) == 0 {
+					return written, fmt.Errorf("failed to send %s: %w", day.Key, err)
+				}
+				// the ones already sent still need to be confirmed
+				mined, waitErr := wait(ctx, backend, sent, batch)
+				if waitErr != nil {
+					return written + mined, fmt.Errorf("failed to send %s: %w (%d of the %d already sent were mined: %v)", day.Key, err, mined, len(sent), waitErr)
+				}
+				return written + mined, fmt.Errorf("failed to send %s: %w", day.Key, err)
+			}
+			sent = append(sent, tx)
+			nonce++
+		}
+
+		mined, err := wait(ctx, backend, sent, batch)
+		written += mined
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// Waits for each transaction in order and stops at the first revert
+func wait(ctx context.Context, backend Backend, sent []*types.Transaction, batch []Day) (int, error) {
+	for i, tx := range sent {
+		receipt, err := bind.WaitMined(ctx, backend, tx)
+		if err != nil {
+			return i, fmt.Errorf("waiting for %s (%s): %v", batch[i].Key, tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return i, fmt.Errorf("%s (%s) reverted", batch[i].Key, tx.Hash().Hex())
+		}
+	}
+	return len(sent), nil
+}
+
+// Compares the target against the expected days and fills Verified and Mismatches
+func Verify(opts *bind.CallOpts, backend bind.ContractCaller, target common.Address, days []Day, report *Report) error {
+	for _, day := range days {
+		stored, _, err := api.ReadDay(opts, backend, target, day.Key.Timestamp())
+		if err != nil {
+			return fmt.Errorf("failed to read %s from the target: %v", day.Key, err)
+		}
+		var reason string
+		switch {
+		case stored.Updatedat.Sign() == 0:
+			reason = "missing"
+		case stored.Value.Cmp(day.Value) != 0:
+			reason = fmt.Sprintf("value %s, expected %s", stored.Value, day.Value)
+		case stored.Updatedat.Cmp(day.UpdatedAt) != 0:
+			reason = fmt.Sprintf("updatedat %s, expected %s", stored.Updatedat, day.UpdatedAt)
+		case stored.Confidence != day.Confidence:
+			reason = fmt.Sprintf("confidence %d, expected %d", stored.Confidence, day.Confidence)
+		case stored.Decimal != report.TargetDecimals:
+			reason = fmt.Sprintf("decimal %d, expected %d", stored.Decimal, report.TargetDecimals)
+		}
+		if reason != "" {
+			report.Mismatches = append(report.Mismatches, Mismatch{Date: day.Key.String(), Reason: reason})
+			continue
+		}
+		report.Verified++
+	}
+	return nil
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,258 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"abi/api"
+	"abi/datekey"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestRunRescalesAndVerifies(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.New(t, "CDI", 6)
+
+	// 03/01 is missing on purpose
+	start := datekey.FromDate(2024, time.January, 1)
+	stored := []struct {
+		key   datekey.Key
+		value int64
+	}{
+		{start, 43739},
+		{start + 1, 43739},
+		{start + 3, 43740},
+		{start + 4, 43700},
+		{datekey.FromDate(2024, time.January, 8), 43741},
+	}
+	for _, day := range stored {
+		tx, err := chain.Oracle.SaveIndicator(chain.Admin, day.key.Timestamp(), big.NewInt(day.value), big.NewInt(day.key.Unix()+3600), 90)
+		if err != nil {
+			t.Fatalf("save %s: %v", day.key, err)
+		}
+		chain.Mine(t, tx)
+	}
+
+	target, tx, oracle, err := api.DeployOracleIndicator(chain.Admin, chain.Client, "CDI", 8, chain.Admin.From)
+	if err != nil {
+		t.Fatalf("deploy target: %v", err)
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 10*time.Millisecond)
+
+	opts := Options{Start: start, End: start + 14, BatchSize: 2}
+	report := Run(ctx, chain.Client, chain.Admin, chain.Address, target, opts)
+	if report.Err == "" || !strings.Contains(report.Err, "rescaling is required") {
+		t.Fatalf("expected refusal without rescale, got %+v", report)
+	}
+
+	opts.Rescale = true
+	report = Run(ctx, chain.Client, chain.Admin, chain.Address, target, opts)
+	if err := report.Check(); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if report.Read != 5 || report.Written != 5 || report.Verified != 5 || report.Skipped != 0 || !report.Rescaled {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if report.SourceCheckpoints == nil || *report.SourceCheckpoints != 5 {
+		t.Fatalf("source checkpoints: %v", report.SourceCheckpoints)
+	}
+
+	for _, day := range stored {
+		read, err := oracle.GetDay(chain.CallOpts(chain.Admin.From), day.key.Timestamp())
+		if err != nil {
+			t.Fatalf("read %s: %v", day.key, err)
+		}
+		got := read.Feed
+		if want := big.NewInt(day.value * 100); got.Value.Cmp(want) != 0 || got.Decimal != 8 || got.Confidence != 90 {
+			t.Errorf("%s: got %s (%d decimals, confidence %d), want %s", day.key, got.Value, got.Decimal, got.Confidence, want)
+		}
+	}
+
+	// a rerun finds everything in place
+	report = Run(ctx, chain.Client, chain.Admin, chain.Address, target, opts)
+	if err := report.Check(); err != nil || report.Skipped != 5 || report.Written != 0 || report.Verified != 5 {
+		t.Fatalf("rerun: %v %+v", err, report)
+	}
+
+	var text bytes.Buffer
+	report.Write(&text)
+	if !strings.Contains(text.String(), "read 5 days (source checkpoints: 5), skipped 5, written 0, verified 5") {
+		t.Errorf("text report:\n%s", text.String())
+	}
+}
+
+func TestRunNarrowRangeFailsCheck(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	start := datekey.FromDate(2024, time.January, 1)
+	for _, key := range []datekey.Key{start, start + 10} {
+		tx, err := chain.Oracle.SaveIndicator(chain.Admin, key.Timestamp(), big.NewInt(4373900), big.NewInt(key.Unix()), 100)
+		if err != nil {
+			t.Fatalf("save %s: %v", key, err)
+		}
+		chain.Mine(t, tx)
+	}
+	target, tx, _, err := api.DeployOracleIndicator(chain.Admin, chain.Client, "CDI", 8, chain.Admin.From)
+	if err != nil {
+		t.Fatalf("deploy target: %v", err)
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 10*time.Millisecond)
+
+	report := Run(context.Background(), chain.Client, chain.Admin, chain.Address, target, Options{Start: start, End: start + 5, BatchSize: 10})
+	if err := report.Check(); err == nil || !strings.Contains(err.Error(), "widen the date range") {
+		t.Fatalf("expected checkpoint mismatch, got %v (%+v)", err, report)
+	}
+}
+
+func TestRunSkipsRetractedAndBackfills(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.New(t, "CDI", 8)
+	start := datekey.FromDate(2024, time.January, 1)
+	save := func(oracle *api.OracleIndicator, key datekey.Key) {
+		t.Helper()
+		tx, err := oracle.SaveIndicator(chain.Admin, key.Timestamp(), big.NewInt(4373900), big.NewInt(key.Unix()), 100)
+		if err != nil {
+			t.Fatalf("save %s: %v", key, api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+	}
+	for i := datekey.Key(0); i < 4; i++ {
+		save(chain.Oracle, start+i)
+	}
+	tx, err := chain.Oracle.Invalidate(chain.Admin, (start + 2).Timestamp())
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	// the target already holds the last day and rejects every value through its limits
+	target, tx, oracle, err := api.DeployOracleIndicator(chain.Admin, chain.Client, "CDI", 8, chain.Admin.From)
+	if err != nil {
+		t.Fatalf("deploy target: %v", err)
+	}
+	chain.Mine(t, tx)
+	save(oracle, start+3)
+	tx, err = oracle.SetLimits(chain.Admin, big.NewInt(0), big.NewInt(1), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 10*time.Millisecond)
+
+	opts := Options{Start: start, End: start + 5, BatchSize: 5, DryRun: true}
+	report := Run(ctx, chain.Client, chain.Admin, chain.Address, target, opts)
+	if err := report.Check(); err != nil || report.Read != 4 || report.Skipped != 1 || report.Written != 0 {
+		t.Fatalf("dry run: %v %+v", err, report)
+	}
+	if len(report.Retracted) != 1 || report.Retracted[0] != (start+2).String() {
+		t.Errorf("retracted %v", report.Retracted)
+	}
+	if len(report.OutOfOrder) != 2 || report.OutOfOrder[0] != start.String() || report.OutOfOrder[1] != (start+1).String() {
+		t.Errorf("out of order %v", report.OutOfOrder)
+	}
+	var text bytes.Buffer
+	report.Write(&text)
+	if !strings.Contains(text.String(), "dry run, nothing sent: 2 days to write") {
+		t.Errorf("text report:\n%s", text.String())
+	}
+	if day, err := oracle.GetDay(chain.CallOpts(chain.Admin.From), start.Timestamp()); err != nil || day.Feed.Updatedat.Sign() != 0 {
+		t.Fatalf("dry run wrote %+v, %v", day.Feed, err)
+	}
+
+	opts.DryRun = false
+	report = Run(ctx, chain.Client, chain.Admin, chain.Address, target, opts)
+	if err := report.Check(); err != nil || report.Written != 2 || report.Verified != 3 {
+		t.Fatalf("run: %v %+v", err, report)
+	}
+	if day, err := oracle.GetDay(chain.CallOpts(chain.Admin.From), (start + 2).Timestamp()); err != nil || day.Feed.Updatedat.Sign() != 0 {
+		t.Fatalf("retracted day copied: %+v, %v", day.Feed, err)
+	}
+
+	// overrideIndicator is reserved to the admin
+	_, outsider := chain.NewAccount(t)
+	report = Run(ctx, chain.Client, outsider, chain.Address, target, opts)
+	if report.Err == "" || !strings.Contains(report.Err, "DEFAULT_ADMIN_ROLE") {
+		t.Fatalf("run without the admin role: %+v", report)
+	}
+}
+
+// Backend whose nth SendTransaction fails before reaching the node
+type failingSend struct {
+	Backend
+	sends  int
+	failAt int
+}
+
+func (b *failingSend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.sends++
+	if b.sends == b.failAt {
+		return errors.New("connection reset")
+	}
+	return b.Backend.SendTransaction(ctx, tx)
+}
+
+// A send failure mid-batch counts only what the earlier sends actually mined
+func TestWriteCountsMinedOnSendFailure(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.New(t, "CDI", 6)
+	chain.AutoMine(t, 10*time.Millisecond)
+
+	// without PUBLISHER_ROLE the first day is mined but reverts; the fixed gas
+	// limit skips the estimate that would catch it before sending
+	_, outsider := chain.NewAccount(t)
+	outsider.GasLimit = 500_000
+	backend := &failingSend{Backend: chain.Client, failAt: 2}
+	target, err := api.NewOracleIndicatorTransactor(chain.Address, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := datekey.FromDate(2024, time.January, 2)
+	days := []Day{
+		{Key: start, Value: big.NewInt(43739), UpdatedAt: big.NewInt(start.Unix()), Confidence: 100},
+		{Key: start + 1, Value: big.NewInt(43739), UpdatedAt: big.NewInt(start.Unix()), Confidence: 100},
+	}
+
+	written, err := Write(ctx, backend, outsider, target, days, 2, false)
+	if err == nil || !strings.Contains(err.Error(), "connection reset") || !strings.Contains(err.Error(), "reverted") {
+		t.Fatalf("error = %v", err)
+	}
+	if written != 0 {
+		t.Fatalf("written = %d, want 0", written)
+	}
+}
+
+func TestRescale(t *testing.T) {
+	start := datekey.FromDate(2024, time.January, 1)
+	days := []Day{
+		{Key: start, Value: big.NewInt(4373900)},
+		{Key: start + 1, Value: big.NewInt(4373912)},
+		{Key: start + 2, Value: big.NewInt(-4373900)},
+	}
+
+	down, truncated := Rescale(days, 8, 6)
+	want := []int64{43739, 43739, -43739}
+	for i, day := range down {
+		if day.Value.Int64() != want[i] {
+			t.Errorf("day %d: got %s, want %d", i, day.Value, want[i])
+		}
+	}
+	if len(truncated) != 1 || truncated[0] != "02/01/2024" {
+		t.Errorf("truncated: %v", truncated)
+	}
+	if days[0].Value.Int64() != 4373900 {
+		t.Errorf("input modified: %s", days[0].Value)
+	}
+
+	up, truncated := Rescale(days[:1], 6, 8)
+	if up[0].Value.Int64() != 437390000 || truncated != nil {
+		t.Errorf("up: %s %v", up[0].Value, truncated)
+	}
+}
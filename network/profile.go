@@ -0,0 +1,212 @@
+package network
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"abi/failover"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Fee settings applied to every transaction sent on a network.
+// Zero values leave the choice to go-ethereum's estimation.
+type FeePolicy struct {
+	GasLimit           uint64  `json:"gasLimit"`
+	GasPriceGwei       float64 `json:"gasPriceGwei"`
+	MaxFeeGwei         float64 `json:"maxFeeGwei"`
+	MaxPriorityFeeGwei float64 `json:"maxPriorityFeeGwei"`
+}
+
+// Series the contract on a network is expected to hold
+type Series struct {
+	Name     string `json:"name"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// Deployment target for the publisher
+type Profile struct {
+	Name      string   `json:"name"`
+	RPCURL    string   `json:"rpcUrl"`
+	Fallbacks []string `json:"fallbackRpcUrls"`
+	MaxLag    uint64   `json:"maxLag"`
+	Quorum    int      `json:"readQuorum"` // endpoints that must agree on reads, fewer than the endpoints configured
+	ChainID   int64    `json:"chainId"`
+	Contract  string   `json:"contract"`
+	SignerEnv string   `json:"signerEnv"`
+	// Key that signs off-chain reports; it needs REPORTER_ROLE but no funds
+	ReporterEnv string    `json:"reporterEnv"`
+	Fees        FeePolicy `json:"fees"`
+	Series      Series    `json:"series"`
+	GasReport   string    `json:"gasReport"`
+}
+
+// Reads the network profiles from a JSON file
+func Load(path string) ([]Profile, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []Profile
+	if err := json.Unmarshal(body, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	seen := make(map[string]bool)
+	for i, p := range profiles {
+		if err := p.validate(); err != nil {
+			return nil, fmt.Errorf("profile %d in %s: %v", i, path, err)
+		}
+		if seen[p.Name] {
+			return nil, fmt.Errorf("duplicate profile name %q in %s", p.Name, path)
+		}
+		seen[p.Name] = true
+		if p.GasReport == "" {
+			profiles[i].GasReport = "gasreport.csv"
+		}
+	}
+
+	return profiles, nil
+}
+
+// Filters profiles by a comma separated list of names, keeping all when the list is empty
+func Select(profiles []Profile, names string) ([]Profile, error) {
+	if strings.TrimSpace(names) == "" {
+		return profiles, nil
+	}
+
+	byName := make(map[string]Profile, len(profiles))
+	for _, p := range profiles {
+		byName[p.Name] = p
+	}
+
+	var selected []Profile
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		p, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown network profile %q", name)
+		}
+		selected = append(selected, p)
+	}
+	return selected, nil
+}
+
+func (p Profile) validate() error {
+	switch {
+	case p.Name == "":
+		return fmt.Errorf("missing name")
+	case p.RPCURL == "":
+		return fmt.Errorf("%s: missing rpcUrl", p.Name)
+	case p.ChainID <= 0:
+		return fmt.Errorf("%s: missing chainId", p.Name)
+	case !common.IsHexAddress(p.Contract):
+		return fmt.Errorf("%s: invalid contract address %q", p.Name, p.Contract)
+	case p.SignerEnv == "":
+		return fmt.Errorf("%s: missing signerEnv", p.Name)
+	case p.Quorum > 1 && p.Quorum > len(p.Fallbacks):
+		return fmt.Errorf("%s: readQuorum %d needs at least %d fallbackRpcUrls", p.Name, p.Quorum, p.Quorum)
+	}
+	return nil
+}
+
+// Address of the OracleIndicator deployment on this network
+func (p Profile) ContractAddress() common.Address {
+	return common.HexToAddress(p.Contract)
+}
+
+// Dials the RPC endpoint and its fallbacks and checks that every one of them
+// serves the configured chain
+func (p Profile) Connect(ctx context.Context) (*failover.Client, error) {
+	client, err := failover.Dial(ctx, failover.Config{
+		URLs:       append([]string{p.RPCURL}, p.Fallbacks...),
+		MaxLag:     p.MaxLag,
+		ReadQuorum: p.Quorum,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %v", p.Name, err)
+	}
+
+	if err := client.VerifyChainID(ctx, big.NewInt(p.ChainID)); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("chain ID check failed for %s: %v", p.Name, err)
+	}
+	return client, nil
+}
+
+// Compares the configured chain ID with the one reported by the node
+func (p Profile) VerifyChainID(ctx context.Context, client interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read chain ID from %s: %v", p.RPCURL, err)
+	}
+	if chainID.Cmp(big.NewInt(p.ChainID)) != 0 {
+		return fmt.Errorf("chain ID mismatch for %s: configured %d, node reports %s", p.Name, p.ChainID, chainID)
+	}
+	return nil
+}
+
+// Builds the transaction signer from the private key in SignerEnv and applies the fee policy
+func (p Profile) Transactor() (*bind.TransactOpts, error) {
+	key, err := p.loadKey(p.SignerEnv)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(p.ChainID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authorized transactor: %v", err)
+	}
+
+	p.Fees.apply(auth)
+	return auth, nil
+}
+
+// Loads the private key that signs off-chain reports from ReporterEnv
+func (p Profile) ReporterKey() (*ecdsa.PrivateKey, error) {
+	if p.ReporterEnv == "" {
+		return nil, fmt.Errorf("%s: no reporterEnv configured", p.Name)
+	}
+	return p.loadKey(p.ReporterEnv)
+}
+
+func (p Profile) loadKey(env string) (*ecdsa.PrivateKey, error) {
+	privateKey := os.Getenv(env)
+	if privateKey == "" {
+		return nil, fmt.Errorf("%s: environment variable %s is not set", p.Name, env)
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %v", err)
+	}
+	return key, nil
+}
+
+func (f FeePolicy) apply(auth *bind.TransactOpts) {
+	auth.GasLimit = f.GasLimit
+	if f.GasPriceGwei > 0 {
+		auth.GasPrice = gwei(f.GasPriceGwei)
+		return
+	}
+	if f.MaxFeeGwei > 0 {
+		auth.GasFeeCap = gwei(f.MaxFeeGwei)
+	}
+	if f.MaxPriorityFeeGwei > 0 {
+		auth.GasTipCap = gwei(f.MaxPriorityFeeGwei)
+	}
+}
+
+func gwei(amount float64) *big.Int {
+	wei, _ := new(big.Float).Mul(big.NewFloat(amount), big.NewFloat(1e9)).Int(nil)
+	return wei
+}
@@ -0,0 +1,200 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+
+	"abi/api"
+	"abi/network"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ERC-165 interface ID of OpenZeppelin's IAccessControl
+var AccessControlInterfaceID = [4]byte{0x79, 0x65, 0xdb, 0x0b}
+
+// Node access needed by the checks
+type Backend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Arguments of a representative saveIndicator call used to estimate gas
+type Sample struct {
+	Timestamp *big.Int
+	Value     *big.Int
+	UpdatedAt *big.Int
+}
+
+// Result of a single check
+type Check struct {
+	Name   string
+	OK     bool
+	Detail string
+}
+
+// Results of every check for one network
+type Report struct {
+	Network string
+	Checks  []Check
+}
+
+func (r *Report) add(name string, err error, detail string) bool {
+	if err != nil {
+		r.Checks = append(r.Checks, Check{Name: name, Detail: err.Error()})
+		return false
+	}
+	r.Checks = append(r.Checks, Check{Name: name, OK: true, Detail: detail})
+	return true
+}
+
+// Whether any check failed
+func (r *Report) Failed() bool {
+	for _, c := range r.Checks {
+		if !c.OK {
+			return true
+		}
+	}
+	return false
+}
+
+// Error listing the failed checks, nil when all passed
+func (r *Report) Err() error {
+	if !r.Failed() {
+		return nil
+	}
+	var failed []string
+	for _, c := range r.Checks {
+		if !c.OK {
+			failed = append(failed, c.Name)
+		}
+	}
+	return fmt.Errorf("preflight failed on %s: %v", r.Network, failed)
+}
+
+func (r *Report) Write(w io.Writer) {
+	fmt.Fprintf(w, "Preflight %s:\n", r.Network)
+	for _, c := range r.Checks {
+		status := "ok  "
+		if !c.OK {
+			status = "FAIL"
+		}
+		fmt.Fprintf(w, "  [%s] %s: %s\n", status, c.Name, c.Detail)
+	}
+}
+
+// Runs every check for publishing batch transactions like sample from auth.From.
+// Checks that depend on a failed one (e.g. calls on a missing contract) are skipped,
+// and so is the balance check when there is no sample to estimate.
+func Run(ctx context.Context, backend Backend, profile network.Profile, auth *bind.TransactOpts, batch int, sample *Sample) *Report {
+	report := &Report{Network: profile.Name}
+	address := profile.ContractAddress()
+
+	report.add("chain id", profile.VerifyChainID(ctx, backend), fmt.Sprintf("%d", profile.ChainID))
+
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err == nil && len(code) == 0 {
+		err = fmt.Errorf("no code at %s", address.Hex())
+	}
+	if !report.add("contract code", err, fmt.Sprintf("%d bytes at %s", len(code), address.Hex())) {
+		return report
+	}
+
+	oracle, err := api.NewOracleIndicatorCaller(address, backend)
+	if err != nil {
+		report.add("binding", err, "")
+		return report
+	}
+	opts := &bind.CallOpts{Context: ctx, From: auth.From}
+
+	supported, err := oracle.SupportsInterface(opts, AccessControlInterfaceID)
+	if err == nil && !supported {
+		err = fmt.Errorf("contract does not report the AccessControl interface %#x", AccessControlInterfaceID)
+	}
+	if !report.add("access control", err, "IAccessControl supported") {
+		return report
+	}
+
+	role, err := api.PublisherRole(opts, backend, address)
+	roleName := api.RoleNames[role]
+	if err == nil {
+		var has bool
+		has, err = oracle.HasRole(opts, role, auth.From)
+		if err == nil && !has {
+			err = fmt.Errorf("signer %s lacks %s", auth.From.Hex(), roleName)
+		}
+	}
+	report.add("signer role", err, fmt.Sprintf("%s holds %s", auth.From.Hex(), roleName))
+
+	report.add("series", checkSeries(oracle, opts, profile.Series), fmt.Sprintf("%q with %d decimals", profile.Series.Name, profile.Series.Decimals))
+
+	if sample == nil {
+		report.add("balance", nil, "not estimated, no value to send")
+		return report
+	}
+	cost, err := estimateBatch(ctx, backend, address, auth, batch, *sample)
+	if err == nil {
+		var balance *big.Int
+		balance, err = backend.BalanceAt(ctx, auth.From, nil)
+		if err == nil && balance.Cmp(cost) < 0 {
+			err = fmt.Errorf("balance %s wei does not cover estimated cost %s wei for %d transactions", balance, cost, batch)
+		}
+	}
+	report.add("balance", err, fmt.Sprintf("covers estimated %s wei for %d transactions", cost, batch))
+
+	return report
+}
+
+func checkSeries(oracle *api.OracleIndicatorCaller, opts *bind.CallOpts, series network.Series) error {
+	if series.Name == "" {
+		return nil
+	}
+	name, err := oracle.GetName(opts)
+	if err != nil {
+		return err
+	}
+	decimals, err := oracle.Decimal(opts)
+	if err != nil {
+		return err
+	}
+	if name != series.Name || decimals != series.Decimals {
+		return fmt.Errorf("contract is %q with %d decimals, configured %q with %d", name, decimals, series.Name, series.Decimals)
+	}
+	return nil
+}
+
+// Gas of one sample saveIndicator times the batch size, priced at the
+// configured fee cap or gas price, or at the node's suggestion
+func estimateBatch(ctx context.Context, backend Backend, address common.Address, auth *bind.TransactOpts, batch int, sample Sample) (*big.Int, error) {
+	parsed, err := api.OracleIndicatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack("saveIndicator", sample.Timestamp, sample.Value, sample.UpdatedAt, uint8(0))
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := backend.EstimateGas(ctx, ethereum.CallMsg{From: auth.From, To: &address, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate saveIndicator gas: %v", api.DecodeError(err))
+	}
+
+	price := auth.GasFeeCap
+	if price == nil {
+		price = auth.GasPrice
+	}
+	if price == nil {
+		if price, err = backend.SuggestGasPrice(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), price)
+	return cost.Mul(cost, big.NewInt(int64(batch))), nil
+}
@@ -0,0 +1,101 @@
+package publisher
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"abi/api"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Basis points in one whole
+const bps = 10000
+
+// Bounds and deviation limit saveIndicator enforces, as configured by setLimits
+type Limits struct {
+	Min             *big.Int
+	Max             *big.Int
+	MaxDeviationBps uint16
+}
+
+// Value that saveIndicator would reject, named after the contract error
+type LimitError struct {
+	Name   string // ValueOutOfBounds or DeviationTooLarge
+	Value  *big.Int
+	Reason string
+}
+
+func (e *LimitError) Error() string {
+	return e.Name + ": " + e.Reason
+}
+
+// Reads the contract's limits and the value deviations are measured from.
+// The base is nil when the deviation check does not apply to the next value.
+// Contracts deployed before limits existed report no limits.
+func ReadLimits(opts *bind.CallOpts, oracle *api.OracleIndicatorCaller) (Limits, *big.Int, error) {
+	min, err := oracle.MinValue(opts)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return Limits{Min: new(big.Int), Max: new(big.Int)}, nil, nil
+		}
+		return Limits{}, nil, fmt.Errorf("failed to read limits: %v", err)
+	}
+	max, err := oracle.MaxValue(opts)
+	if err != nil {
+		return Limits{}, nil, err
+	}
+	deviation, err := oracle.MaxDeviationBps(opts)
+	if err != nil {
+		return Limits{}, nil, err
+	}
+	base, err := ReadDeviationBase(opts, oracle)
+	if err != nil {
+		return Limits{}, nil, err
+	}
+	return Limits{Min: min, Max: max, MaxDeviationBps: deviation}, base, nil
+}
+
+// Reads the value the next deviation is measured from, nil when the check does
+// not apply. Only a day after the last stored one moves it, so a backfill leaves it.
+func ReadDeviationBase(opts *bind.CallOpts, oracle *api.OracleIndicatorCaller) (*big.Int, error) {
+	base, err := oracle.DeviationBase(opts)
+	if err != nil {
+		if strings.Contains(err.Error(), "execution reverted") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read deviation base: %v", err)
+	}
+	if !base.Active {
+		return nil, nil
+	}
+	return base.Value, nil
+}
+
+// Applies the contract's rules to value, with deviations measured from base
+// (nil skips the deviation check)
+func (l Limits) Check(value, base *big.Int) error {
+	if (l.Min.Sign() != 0 || l.Max.Sign() != 0) && (value.Cmp(l.Min) < 0 || value.Cmp(l.Max) > 0) {
+		return &LimitError{
+			Name:   "ValueOutOfBounds",
+			Value:  value,
+			Reason: fmt.Sprintf("%s outside [%s, %s]", value, l.Min, l.Max),
+		}
+	}
+	if base == nil || base.Sign() == 0 || l.MaxDeviationBps == 0 {
+		return nil
+	}
+	distance := new(big.Int).Sub(value, base)
+	distance.Abs(distance).Mul(distance, big.NewInt(bps))
+	allowed := new(big.Int).Abs(base)
+	allowed.Mul(allowed, big.NewInt(int64(l.MaxDeviationBps)))
+	if distance.Cmp(allowed) > 0 {
+		return &LimitError{
+			Name:   "DeviationTooLarge",
+			Value:  value,
+			Reason: fmt.Sprintf("%s moves more than %d bps from %s", value, l.MaxDeviationBps, base),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package publisher_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"abi/api"
+	"abi/network"
+	"abi/publisher"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestLimitsMatchContract(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	opts := chain.CallOpts(chain.Admin.From)
+	tx, err := chain.Oracle.SetLimits(chain.Admin, big.NewInt(90_000_000), big.NewInt(110_000_000), 500)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+
+	_, err = chain.Oracle.SetLimits(chain.Admin, big.NewInt(2), big.NewInt(1), 0)
+	simtest.RequireRevert(t, err, "InvalidLimits")
+
+	day := int64(1704153600)
+	for i, test := range []struct {
+		value  int64
+		reject string
+	}{
+		{89_999_999, "ValueOutOfBounds"},
+		{100_000_000, ""}, // first value: no deviation base yet
+		{105_000_000, ""}, // exactly 5%
+		{99_000_000, "DeviationTooLarge"},
+		{110_000_001, "ValueOutOfBounds"},
+		{110_000_000, ""},
+	} {
+		limits, base, err := publisher.ReadLimits(opts, &chain.Oracle.OracleIndicatorCaller)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkErr := limits.Check(big.NewInt(test.value), base)
+		var limitErr *publisher.LimitError
+		if test.reject == "" && checkErr != nil || test.reject != "" && (!errors.As(checkErr, &limitErr) || limitErr.Name != test.reject) {
+			t.Fatalf("case %d: Check(%d) = %v, want %q", i, test.value, checkErr, test.reject)
+		}
+
+		tx, err := chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(day), big.NewInt(test.value), big.NewInt(day), 100)
+		if test.reject != "" {
+			simtest.RequireRevert(t, err, test.reject)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: %v", i, api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+		day += 86400
+	}
+
+	// the admin override stores a legitimate large move and becomes the new base
+	_, outsider := chain.NewAccount(t)
+	_, err = chain.Oracle.OverrideIndicator(outsider, big.NewInt(day), big.NewInt(150_000_000), big.NewInt(day), 100)
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	tx, err = chain.Oracle.OverrideIndicator(chain.Admin, big.NewInt(day), big.NewInt(150_000_000), big.NewInt(day), 100)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	if _, base, err := publisher.ReadLimits(opts, &chain.Oracle.OracleIndicatorCaller); err != nil || base.Int64() != 150_000_000 {
+		t.Fatalf("base after override = %v, %v", base, err)
+	}
+}
+
+func TestPublishSkipsValuesOutsideLimits(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	chain := simtest.New(t, "CDI", 6)
+	tx, err := chain.Oracle.SetLimits(chain.Admin, big.NewInt(0), big.NewInt(0), 100)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 20*time.Millisecond)
+	t.Setenv(signerEnv, hex.EncodeToString(crypto.FromECDSA(chain.AdminKey)))
+
+	profile := network.Profile{
+		Name:      "simulated",
+		ChainID:   simtest.ChainID,
+		Contract:  chain.Address.Hex(),
+		SignerEnv: signerEnv,
+		GasReport: filepath.Join(t.TempDir(), "gasreport.csv"),
+	}
+	published := time.Unix(1704272400, 0)
+	observations := []publisher.Observation{
+		{Date: "02/01/2024", Timestamp: big.NewInt(1704153600), Value: big.NewInt(43739), UpdatedAt: published},
+		{Date: "03/01/2024", Timestamp: big.NewInt(1704240000), Value: big.NewInt(87478), UpdatedAt: published},
+		{Date: "04/01/2024", Timestamp: big.NewInt(1704326400), Value: big.NewInt(43750), UpdatedAt: published},
+	}
+
+	// the doubled value is rejected before sending and the next day is checked
+	// against the last accepted one
+	status := publisher.Publish(context.Background(), logger, chain.Client, profile, observations, publisher.Options{SkipPreflight: true})
+	if status.Err != nil || status.Sent != 2 || status.Failed != 1 {
+		t.Fatalf("publish = %+v", status)
+	}
+	stored, err := chain.Oracle.GetDay(chain.CallOpts(chain.Admin.From), big.NewInt(1704240000))
+	if err != nil || stored.Feed.Updatedat.Sign() != 0 {
+		t.Fatalf("rejected day stored: %+v, %v", stored.Feed, err)
+	}
+}
+
+// A backfilled day does not move the on-chain base, so the next day is
+// checked against the last stored day rather than the backfill
+func TestPublishBackfillKeepsDeviationBase(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	chain := simtest.New(t, "CDI", 6)
+	tx, err := chain.Oracle.SetLimits(chain.Admin, big.NewInt(0), big.NewInt(0), 1000)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	last := int64(1704326400)
+	tx, err = chain.Oracle.SaveIndicator(chain.Admin, big.NewInt(last), big.NewInt(100_000), big.NewInt(last), 100)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 20*time.Millisecond)
+	t.Setenv(signerEnv, hex.EncodeToString(crypto.FromECDSA(chain.AdminKey)))
+
+	profile := network.Profile{
+		Name:      "simulated",
+		ChainID:   simtest.ChainID,
+		Contract:  chain.Address.Hex(),
+		SignerEnv: signerEnv,
+		GasReport: filepath.Join(t.TempDir(), "gasreport.csv"),
+	}
+	published := time.Unix(last+86400, 0)
+	observations := []publisher.Observation{
+		// 9% above the stored day, backfilled before it
+		{Date: "02/01/2024", Timestamp: big.NewInt(last - 2*86400), Value: big.NewInt(109_000), UpdatedAt: published},
+		// 8% below the stored day, but 15% below the backfill
+		{Date: "05/01/2024", Timestamp: big.NewInt(last + 86400), Value: big.NewInt(92_000), UpdatedAt: published},
+	}
+
+	status := publisher.Publish(context.Background(), logger, chain.Client, profile, observations, publisher.Options{SkipPreflight: true})
+	if status.Err != nil || status.Sent != 2 || status.Failed != 0 {
+		t.Fatalf("publish = %+v", status)
+	}
+	base, err := publisher.ReadDeviationBase(chain.CallOpts(chain.Admin.From), &chain.Oracle.OracleIndicatorCaller)
+	if err != nil || base.Int64() != 92_000 {
+		t.Fatalf("deviation base = %v, %v", base, err)
+	}
+}
+
+// The preflight estimates gas on a value the limits accept, and skips the
+// estimate when none is accepted, instead of failing on the first value
+func TestPreflightSampleWithinLimits(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	chain := simtest.New(t, "CDI", 6)
+	tx, err := chain.Oracle.SetLimits(chain.Admin, big.NewInt(40_000), big.NewInt(50_000), 0)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	chain.AutoMine(t, 20*time.Millisecond)
+	t.Setenv(signerEnv, hex.EncodeToString(crypto.FromECDSA(chain.AdminKey)))
+
+	profile := network.Profile{
+		Name:      "simulated",
+		ChainID:   simtest.ChainID,
+		Contract:  chain.Address.Hex(),
+		SignerEnv: signerEnv,
+		GasReport: filepath.Join(t.TempDir(), "gasreport.csv"),
+	}
+	published := time.Unix(1704272400, 0)
+	outside := publisher.Observation{Date: "02/01/2024", Timestamp: big.NewInt(1704153600), Value: big.NewInt(87478), UpdatedAt: published}
+	inside := publisher.Observation{Date: "03/01/2024", Timestamp: big.NewInt(1704240000), Value: big.NewInt(43739), UpdatedAt: published}
+
+	status := publisher.Publish(context.Background(), logger, chain.Client, profile, []publisher.Observation{outside, inside}, publisher.Options{})
+	if status.Err != nil || status.Sent != 1 || status.Failed != 1 {
+		t.Fatalf("publish = %+v", status)
+	}
+	status = publisher.Publish(context.Background(), logger, chain.Client, profile, []publisher.Observation{outside}, publisher.Options{})
+	if status.Err != nil || status.Sent != 0 || status.Failed != 1 {
+		t.Fatalf("publish with no accepted value = %+v", status)
+	}
+}
@@ -0,0 +1,316 @@
+package publisher
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"time"
+
+	"abi/api"
+	"abi/metrics"
+	"abi/network"
+	"abi/preflight"
+	"abi/validate"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Sources for the _updatedat argument of saveIndicator
+const (
+	UpdatedAtSource = "source"
+	UpdatedAtBlock  = "block"
+	UpdatedAtNow    = "now"
+)
+
+// Fixed-point scale applied to BCB values before they are stored
+const valueScale = 1e6
+
+// Confidence of a value validated from the official source, in percent
+const sourceConfidence = 100
+
+// How often a sent transaction's receipt is polled
+var ReceiptPollInterval = time.Second
+
+// Parsed entry ready to be written on-chain
+type Observation struct {
+	Date       string
+	Timestamp  *big.Int
+	Value      *big.Int
+	UpdatedAt  time.Time // when the source published or served the value
+	Confidence uint8     // stored with the value, in percent
+}
+
+// Publishing settings shared by every network
+type Options struct {
+	Series        string // label used in metrics
+	UpdatedAt     string
+	SkipPreflight bool
+}
+
+// Outcome of publishing the series on one network
+type Status struct {
+	Network string
+	Sent    int
+	Failed  int
+	Err     error
+}
+
+// Node access needed to publish
+type Backend interface {
+	preflight.Backend
+	bind.DeployBackend
+}
+
+// Converts validated entries to the contract's fixed-point values and day buckets,
+// each stamped with the time its source published it
+func Scale(logger *slog.Logger, accepted []validate.Observation) []Observation {
+	var observations []Observation
+	for _, entry := range accepted {
+
+		timestamp := entry.Key.Timestamp()
+
+		value := new(big.Float).Set(entry.Value)
+		scale := new(big.Float).SetFloat64(valueScale)
+		value.Mul(value, scale)
+
+		intValue := new(big.Int)
+		value.Int(intValue)
+
+		logger.Debug("Scaled observation", "date", entry.Text, "timestamp", timestamp.Int64(),
+			"raw", entry.Value.String(), "value", intValue.String())
+
+		observations = append(observations, Observation{Date: entry.Text, Timestamp: timestamp, Value: intValue, UpdatedAt: entry.PublishedAt, Confidence: sourceConfidence})
+	}
+	return observations
+}
+
+// Writes every observation whose day is not already stored with the same value to
+// the profile's contract and waits for each receipt
+func Publish(ctx context.Context, logger *slog.Logger, backend Backend, profile network.Profile, observations []Observation, opts Options) Status {
+	status := Status{Network: profile.Name}
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), backend)
+	if err != nil {
+		status.Err = fmt.Errorf("error initializing contract: %v", err)
+		return status
+	}
+
+	auth, err := profile.Transactor()
+	if err != nil {
+		status.Err = err
+		return status
+	}
+	auth.Context = ctx
+	defer recordSigner(ctx, profile.Name, opts.Series, backend, oracle, auth.From)
+
+	if unsent, err := pending(&bind.CallOpts{Context: ctx, From: auth.From}, backend, profile.ContractAddress(), observations); err != nil {
+		logger.Warn("Failed to read stored days; sending every observation", "err", err)
+	} else {
+		observations = unsent
+	}
+	logger.Info("Pending observations", "count", len(observations))
+	if len(observations) == 0 {
+		return status
+	}
+
+	// values the contract would reject are not sent; the deviation base is read
+	// back after each confirmation, since only a day after the last one moves it
+	limits, base, limitsErr := ReadLimits(&bind.CallOpts{Context: ctx}, &oracle.OracleIndicatorCaller)
+
+	if !opts.SkipPreflight {
+		// gas is estimated on the first value the limits accept, since a rejected
+		// one would fail the estimate; the preflight reports unreadable limits itself
+		var sample *preflight.Sample
+		for _, obs := range observations {
+			if limitsErr == nil && limits.Check(obs.Value, base) != nil {
+				continue
+			}
+			sample = &preflight.Sample{Timestamp: obs.Timestamp, Value: obs.Value, UpdatedAt: big.NewInt(obs.UpdatedAt.Unix())}
+			break
+		}
+		report := preflight.Run(ctx, backend, profile, auth, len(observations), sample)
+		for _, check := range report.Checks {
+			if check.OK {
+				logger.Info("Preflight check passed", "stage", "preflight", "check", check.Name, "detail", check.Detail)
+			} else {
+				logger.Error("Preflight check failed", "stage", "preflight", "check", check.Name, "detail", check.Detail)
+			}
+		}
+		if err := report.Err(); err != nil {
+			status.Err = err
+			return status
+		}
+	}
+
+	if limitsErr != nil {
+		status.Err = limitsErr
+		return status
+	}
+
+	for _, obs := range observations {
+		obsLog := logger.With("date", obs.Date, "value", obs.Value.String())
+
+		if err := limits.Check(obs.Value, base); err != nil {
+			obsLog.Error("Value breaks the contract limits", "stage", "limits", "err", err)
+			status.Failed++
+			continue
+		}
+
+		updatedAt, err := resolveUpdatedAt(ctx, backend, obs, opts.UpdatedAt)
+		if err != nil {
+			obsLog.Error("Failed to resolve updatedat", "stage", "submit", "mode", opts.UpdatedAt, "err", err)
+			status.Failed++
+			continue
+		}
+
+		tx, err := oracle.SaveIndicator(auth, obs.Timestamp, obs.Value, updatedAt, obs.Confidence)
+		if err != nil {
+			err = api.DecodeError(err)
+			obsLog.Error("Failed to save indicator", "stage", "submit", "err", err)
+			metrics.Transactions.WithLabelValues(profile.Name, "failed").Inc()
+			status.Failed++
+			continue
+		}
+		metrics.Transactions.WithLabelValues(profile.Name, "sent").Inc()
+		metrics.Nonce.WithLabelValues(profile.Name).Set(float64(tx.Nonce() + 1))
+		obsLog = obsLog.With("tx", tx.Hash().Hex(), "nonce", tx.Nonce())
+		obsLog.Info("Transaction sent", "stage", "submit", "updated_at", FormatTime(time.Unix(updatedAt.Int64(), 0)))
+
+		receipt, err := WaitForReceipt(ctx, backend, tx.Hash())
+		if err != nil {
+			obsLog.Error("Failed to get transaction receipt", "stage", "confirm", "err", err)
+			status.Failed++
+			continue
+		}
+		recordReceipt(profile.Name, receipt)
+		confirmLog := obsLog.With("stage", "confirm", "block", receipt.BlockNumber.Uint64(), "gas", receipt.GasUsed)
+		if header, err := backend.HeaderByNumber(ctx, receipt.BlockNumber); err == nil {
+			confirmLog = confirmLog.With("submitted_at", FormatTime(time.Unix(int64(header.Time), 0)))
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			confirmLog.Error("Transaction reverted", "reason", api.ReplayRevert(ctx, backend, tx, receipt))
+			status.Failed++
+			continue
+		}
+		status.Sent++
+		confirmLog.Info("Transaction confirmed")
+		if base, err = ReadDeviationBase(&bind.CallOpts{Context: ctx}, &oracle.OracleIndicatorCaller); err != nil {
+			confirmLog.Warn("Failed to read the deviation base; checking later values against none", "err", err)
+		}
+
+		err = saveCSV(profile.GasReport, obs.Timestamp.String(), receipt.GasUsed)
+		if err != nil {
+			confirmLog.Warn("Failed to save transaction details to CSV", "path", profile.GasReport, "err", err)
+		}
+	}
+
+	return status
+}
+
+// Drops observations whose day already holds the same value on-chain and is not
+// retracted, so a rerun only submits new or corrected days. The signer reads the
+// days as an operator through getDay.
+func pending(opts *bind.CallOpts, backend bind.ContractCaller, address common.Address, observations []Observation) ([]Observation, error) {
+	var result []Observation
+	for _, obs := range observations {
+		stored, retracted, err := api.ReadDay(opts, backend, address, obs.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		if stored.Updatedat.Sign() != 0 && stored.Value.Cmp(obs.Value) == 0 && !retracted {
+			continue
+		}
+		result = append(result, obs)
+	}
+	return result, nil
+}
+
+func recordReceipt(name string, receipt *types.Receipt) {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		metrics.Transactions.WithLabelValues(name, "confirmed").Inc()
+	} else {
+		metrics.Transactions.WithLabelValues(name, "reverted").Inc()
+	}
+	metrics.GasUsed.WithLabelValues(name).Add(float64(receipt.GasUsed))
+	if receipt.EffectiveGasPrice != nil {
+		fee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+		wei, _ := new(big.Float).SetInt(fee).Float64()
+		metrics.FeeSpent.WithLabelValues(name).Add(wei)
+	}
+}
+
+// Records the signer's nonce and balance and the age of the last on-chain value.
+// The age needs the signer to have read access and is skipped otherwise.
+func recordSigner(ctx context.Context, name, series string, backend Backend, oracle *api.OracleIndicator, signer common.Address) {
+	if nonce, err := backend.PendingNonceAt(ctx, signer); err == nil {
+		metrics.Nonce.WithLabelValues(name).Set(float64(nonce))
+	}
+	if balance, err := backend.BalanceAt(ctx, signer, nil); err == nil {
+		wei, _ := new(big.Float).SetInt(balance).Float64()
+		metrics.Balance.WithLabelValues(name).Set(wei)
+	}
+	if last, err := oracle.GetLast(&bind.CallOpts{From: signer, Context: ctx}); err == nil && last.Updatedat.Sign() > 0 {
+		metrics.LastValueAge.WithLabelValues(name, series).Set(time.Since(time.Unix(last.Updatedat.Int64(), 0)).Seconds())
+	}
+}
+
+func resolveUpdatedAt(ctx context.Context, backend bind.ContractTransactor, obs Observation, mode string) (*big.Int, error) {
+	switch mode {
+	case UpdatedAtBlock:
+		header, err := backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(header.Time), nil
+	case UpdatedAtNow:
+		return big.NewInt(time.Now().Unix()), nil
+	default:
+		return big.NewInt(obs.UpdatedAt.Unix()), nil
+	}
+}
+
+// RFC 3339 in UTC, or "unknown" for the zero time
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return "unknown"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Polls until the transaction is mined or the context is cancelled
+func WaitForReceipt(ctx context.Context, backend bind.DeployBackend, txHash common.Hash) (*types.Receipt, error) {
+	for {
+		receipt, err := backend.TransactionReceipt(ctx, txHash)
+		if err == nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(ReceiptPollInterval):
+		}
+	}
+}
+
+func saveCSV(path string, timestamp string, gasUsed uint64) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	err = writer.Write([]string{timestamp, fmt.Sprintf("%d", gasUsed)})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
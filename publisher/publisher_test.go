@@ -0,0 +1,185 @@
+package publisher_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"abi/bcbtest"
+	source "abi/json"
+	"abi/network"
+	"abi/publisher"
+	"abi/simtest"
+	"abi/validate"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const signerEnv = "PUBLISHER_TEST_KEY"
+
+// Fake SGS API serving the fixture as series 12
+func fixtureServer(t *testing.T) *bcbtest.Server {
+	body, err := os.ReadFile(filepath.Join("testdata", "sgs12.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []source.Data
+	if err := json.Unmarshal(body, &data); err != nil {
+		t.Fatal(err)
+	}
+	server := bcbtest.NewServer()
+	t.Cleanup(server.Close)
+	server.SetSeries(12, data)
+	server.LastModified = time.Date(2024, 1, 9, 21, 0, 0, 0, time.UTC)
+	return server
+}
+
+func TestPublishPipeline(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	chain := simtest.New(t, "CDI", 6)
+	chain.AutoMine(t, 20*time.Millisecond)
+	t.Setenv(signerEnv, hex.EncodeToString(crypto.FromECDSA(chain.AdminKey)))
+
+	fetcher := source.NewFetcher()
+	fetcher.Cache = source.NewCache(t.TempDir())
+	series, err := fetcher.FetchSeries(ctx, fixtureServer(t).SeriesURL(12))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accepted, report := validate.Validate(series.Data, validate.Rules{})
+	if report.Total != 6 || report.Accepted != 5 || len(report.Rejected) != 1 || report.Normalized != 1 {
+		t.Fatalf("unexpected validation report: %+v", report)
+	}
+	observations := publisher.Scale(logger, accepted)
+
+	profile := network.Profile{
+		Name:      "simulated",
+		ChainID:   simtest.ChainID,
+		Contract:  chain.Address.Hex(),
+		SignerEnv: signerEnv,
+		Series:    network.Series{Name: "CDI", Decimals: 6},
+		GasReport: filepath.Join(t.TempDir(), "gasreport.csv"),
+	}
+	opts := publisher.Options{Series: "sgs.12", UpdatedAt: publisher.UpdatedAtSource}
+
+	status := publisher.Publish(ctx, logger, chain.Client, profile, observations, opts)
+	if status.Err != nil || status.Sent != 5 || status.Failed != 0 {
+		t.Fatalf("publish = %+v", status)
+	}
+
+	role, err := chain.Oracle.READONLY(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := chain.Oracle.GrantRole(chain.Admin, role, chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	published := time.Date(2024, 1, 9, 21, 0, 0, 0, time.UTC).Unix()
+	for _, obs := range observations {
+		feed, err := chain.Oracle.GetDate(chain.CallOpts(chain.Admin.From), obs.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if feed.Value.Int64() != 43739 || feed.Updatedat.Int64() != published || feed.Decimal != 6 || feed.Confidence != 100 {
+			t.Errorf("%s stored as %+v", obs.Date, feed)
+		}
+	}
+	count, err := chain.Oracle.CheckpointCount(chain.CallOpts(chain.Admin.From))
+	if err != nil || count.Int64() != 5 {
+		t.Errorf("checkpointCount = %v, %v", count, err)
+	}
+
+	gasReport, err := os.ReadFile(profile.GasReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(gasReport)), "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], observations[0].Timestamp.String()+",") {
+		t.Errorf("gas report = %q", gasReport)
+	}
+
+	// a rerun does not resend stored days
+	status = publisher.Publish(ctx, logger, chain.Client, profile, observations, opts)
+	if status.Err != nil || status.Sent != 0 || status.Failed != 0 {
+		t.Fatalf("rerun = %+v", status)
+	}
+
+	// a corrected value is resent, and so is a retracted day
+	corrected := append([]publisher.Observation(nil), observations...)
+	last := corrected[len(corrected)-1]
+	last.Value = big.NewInt(43740)
+	corrected[len(corrected)-1] = last
+	tx, err = chain.Oracle.Invalidate(chain.Admin, corrected[0].Timestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	status = publisher.Publish(ctx, logger, chain.Client, profile, corrected, opts)
+	if status.Err != nil || status.Sent != 2 {
+		t.Fatalf("correction = %+v", status)
+	}
+}
+
+func TestPublishWithoutRole(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	chain := simtest.New(t, "CDI", 6)
+	chain.AutoMine(t, 20*time.Millisecond)
+	key, _ := chain.NewAccount(t)
+	t.Setenv(signerEnv, hex.EncodeToString(crypto.FromECDSA(key)))
+
+	profile := network.Profile{
+		Name:      "simulated",
+		ChainID:   simtest.ChainID,
+		Contract:  chain.Address.Hex(),
+		SignerEnv: signerEnv,
+		GasReport: filepath.Join(t.TempDir(), "gasreport.csv"),
+	}
+	observations := []publisher.Observation{{
+		Date:      "02/01/2024",
+		Timestamp: big.NewInt(1704164400),
+		Value:     big.NewInt(43739),
+		UpdatedAt: time.Unix(1704164400, 0),
+	}}
+
+	status := publisher.Publish(context.Background(), logger, chain.Client, profile, observations, publisher.Options{})
+	if status.Err == nil || !strings.Contains(status.Err.Error(), "signer role") {
+		t.Fatalf("expected preflight failure on the signer role, got %+v", status)
+	}
+
+	status = publisher.Publish(context.Background(), logger, chain.Client, profile, observations, publisher.Options{SkipPreflight: true})
+	if status.Err != nil || status.Sent != 0 || status.Failed != 1 {
+		t.Fatalf("publish without preflight = %+v", status)
+	}
+
+	// PUBLISHER_ROLE alone is enough; the admin key stays out of the daily run
+	role, err := chain.Oracle.PUBLISHERROLE(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := chain.Oracle.GrantRole(chain.Admin, role, crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	status = publisher.Publish(context.Background(), logger, chain.Client, profile, observations, publisher.Options{})
+	if status.Err != nil || status.Sent != 1 || status.Failed != 0 {
+		t.Fatalf("publish with PUBLISHER_ROLE = %+v", status)
+	}
+}
@@ -0,0 +1,256 @@
+package publisher
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"time"
+
+	"abi/api"
+	"abi/metrics"
+	"abi/network"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// EIP-712 domain and type the contract's reportDigest hashes
+var reportTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Report": {
+		{Name: "timestamp", Type: "uint256"},
+		{Name: "value", Type: "int256"},
+		{Name: "updatedat", Type: "uint256"},
+		{Name: "confidence", Type: "uint8"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// saveIndicator arguments signed by a reporter, which any account can
+// submit through submitSignedIndicator until the deadline
+type SignedReport struct {
+	Date       string         `json:"date"`
+	ChainID    int64          `json:"chainId"`
+	Contract   common.Address `json:"contract"`
+	Timestamp  *big.Int       `json:"timestamp"`
+	Value      *big.Int       `json:"value"`
+	UpdatedAt  *big.Int       `json:"updatedat"`
+	Confidence uint8          `json:"confidence"`
+	Deadline   *big.Int       `json:"deadline"`
+	Reporter   common.Address `json:"reporter"`
+	Signature  hexutil.Bytes  `json:"signature"`
+}
+
+// EIP-712 hash of the report, equal to the contract's reportDigest
+func (r *SignedReport) Digest() (common.Hash, error) {
+	typed := apitypes.TypedData{
+		Types:       reportTypes,
+		PrimaryType: "Report",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "OracleIndicator",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(r.ChainID),
+			VerifyingContract: r.Contract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"timestamp":  r.Timestamp,
+			"value":      r.Value,
+			"updatedat":  r.UpdatedAt,
+			"confidence": big.NewInt(int64(r.Confidence)),
+			"deadline":   r.Deadline,
+		},
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typed)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// Recovers the address that signed the report
+func (r *SignedReport) Signer() (common.Address, error) {
+	if len(r.Signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature has %d bytes, want %d", len(r.Signature), crypto.SignatureLength)
+	}
+	digest, err := r.Digest()
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig := common.CopyBytes(r.Signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Signs each observation for the profile's chain and contract, to be submitted
+// within validity. The block updatedat mode needs a node and is not available
+// for off-chain reports.
+func SignReports(key *ecdsa.PrivateKey, profile network.Profile, observations []Observation, mode string, validity time.Duration) ([]SignedReport, error) {
+	if validity <= 0 {
+		return nil, fmt.Errorf("report validity must be positive, got %v", validity)
+	}
+	reporter := crypto.PubkeyToAddress(key.PublicKey)
+	deadline := big.NewInt(time.Now().Add(validity).Unix())
+	var reports []SignedReport
+	for _, obs := range observations {
+		var updatedAt *big.Int
+		switch mode {
+		case UpdatedAtBlock:
+			return nil, fmt.Errorf("updatedat mode %q cannot be used for signed reports", mode)
+		case UpdatedAtNow:
+			updatedAt = big.NewInt(time.Now().Unix())
+		default:
+			updatedAt = big.NewInt(obs.UpdatedAt.Unix())
+		}
+
+		report := SignedReport{
+			Date:       obs.Date,
+			ChainID:    profile.ChainID,
+			Contract:   profile.ContractAddress(),
+			Timestamp:  obs.Timestamp,
+			Value:      obs.Value,
+			UpdatedAt:  updatedAt,
+			Confidence: obs.Confidence,
+			Deadline:   deadline,
+			Reporter:   reporter,
+		}
+		digest, err := report.Digest()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", obs.Date, err)
+		}
+		sig, err := crypto.Sign(digest.Bytes(), key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", obs.Date, err)
+		}
+		sig[crypto.RecoveryIDOffset] += 27
+		report.Signature = sig
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// Writes reports as a JSON array
+func WriteReports(path string, reports []SignedReport) error {
+	body, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(body, '\n'), 0o644)
+}
+
+// Reads a file written by WriteReports
+func LoadReports(path string) ([]SignedReport, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var reports []SignedReport
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return reports, nil
+}
+
+// Submits the reports meant for the profile's chain and contract, signed by the
+// profile's signer acting as relayer. Reports already applied are skipped;
+// expired reports and those whose signature does not match their reporter are
+// rejected before sending.
+func Relay(ctx context.Context, logger *slog.Logger, backend Backend, profile network.Profile, reports []SignedReport) Status {
+	status := Status{Network: profile.Name}
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), backend)
+	if err != nil {
+		status.Err = fmt.Errorf("error initializing contract: %v", err)
+		return status
+	}
+	auth, err := profile.Transactor()
+	if err != nil {
+		status.Err = err
+		return status
+	}
+	auth.Context = ctx
+
+	for _, report := range reports {
+		if report.ChainID != profile.ChainID || report.Contract != profile.ContractAddress() {
+			continue
+		}
+		reportLog := logger.With("date", report.Date, "value", report.Value.String(), "reporter", report.Reporter.Hex())
+		if report.Deadline == nil || report.Deadline.Int64() < time.Now().Unix() {
+			reportLog.Error("Report expired", "stage", "relay", "deadline", report.Deadline)
+			status.Failed++
+			continue
+		}
+
+		signer, err := report.Signer()
+		if err == nil && signer != report.Reporter {
+			err = fmt.Errorf("signed by %s", signer.Hex())
+		}
+		if err != nil {
+			reportLog.Error("Invalid report signature", "stage", "relay", "err", err)
+			status.Failed++
+			continue
+		}
+		digest, err := report.Digest()
+		if err != nil {
+			reportLog.Error("Failed to hash report", "stage", "relay", "err", err)
+			status.Failed++
+			continue
+		}
+		submitted, err := oracle.SubmittedReports(&bind.CallOpts{Context: ctx}, digest, report.Reporter)
+		if err != nil {
+			status.Err = fmt.Errorf("failed to read submitted reports: %v", err)
+			return status
+		}
+		if submitted {
+			reportLog.Debug("Report already submitted", "stage", "relay", "digest", digest.Hex())
+			continue
+		}
+
+		tx, err := oracle.SubmitSignedIndicator(auth, report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline, report.Signature)
+		if err != nil {
+			err = api.DecodeError(err)
+			reportLog.Error("Failed to submit report", "stage", "relay", "err", err)
+			metrics.Transactions.WithLabelValues(profile.Name, "failed").Inc()
+			status.Failed++
+			continue
+		}
+		metrics.Transactions.WithLabelValues(profile.Name, "sent").Inc()
+		reportLog = reportLog.With("tx", tx.Hash().Hex(), "nonce", tx.Nonce())
+		reportLog.Info("Report sent", "stage", "relay")
+
+		receipt, err := WaitForReceipt(ctx, backend, tx.Hash())
+		if err != nil {
+			reportLog.Error("Failed to get transaction receipt", "stage", "confirm", "err", err)
+			status.Failed++
+			continue
+		}
+		recordReceipt(profile.Name, receipt)
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			reportLog.Error("Transaction reverted", "stage", "confirm", "reason", api.ReplayRevert(ctx, backend, tx, receipt))
+			status.Failed++
+			continue
+		}
+		status.Sent++
+		reportLog.Info("Report confirmed", "stage", "confirm", "block", receipt.BlockNumber.Uint64(), "gas", receipt.GasUsed)
+	}
+	return status
+}
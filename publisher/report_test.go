@@ -0,0 +1,270 @@
+package publisher_test
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"abi/api"
+	"abi/network"
+	"abi/publisher"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const reporterEnv = "PUBLISHER_TEST_REPORTER_KEY"
+
+func TestSignedReportsRelayedByAnyAccount(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	chain := simtest.New(t, "CDI", 6)
+	reporterKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reporter := crypto.PubkeyToAddress(reporterKey.PublicKey)
+	role, err := chain.Oracle.REPORTERROLE(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := chain.Oracle.GrantRole(chain.Admin, role, reporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	relayerKey, _ := chain.NewAccount(t)
+	chain.AutoMine(t, 20*time.Millisecond)
+	t.Setenv(signerEnv, hex.EncodeToString(crypto.FromECDSA(relayerKey)))
+	t.Setenv(reporterEnv, hex.EncodeToString(crypto.FromECDSA(reporterKey)))
+
+	profile := network.Profile{
+		Name:        "simulated",
+		ChainID:     simtest.ChainID,
+		Contract:    chain.Address.Hex(),
+		SignerEnv:   signerEnv,
+		ReporterEnv: reporterEnv,
+	}
+	key, err := profile.ReporterKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	published := time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)
+	observations := []publisher.Observation{
+		{Date: "02/01/2024", Timestamp: big.NewInt(1704153600), Value: big.NewInt(43739), UpdatedAt: published, Confidence: 100},
+		{Date: "03/01/2024", Timestamp: big.NewInt(1704240000), Value: big.NewInt(43740), UpdatedAt: published.Add(24 * time.Hour), Confidence: 90},
+	}
+	reports, err := publisher.SignReports(key, profile, observations, publisher.UpdatedAtSource, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the Go digest and the contract's agree
+	for _, report := range reports {
+		digest, err := report.Digest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		onchain, err := chain.Oracle.ReportDigest(chain.CallOpts(chain.Admin.From), report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if digest != onchain {
+			t.Fatalf("digest %s, contract %x", digest.Hex(), onchain)
+		}
+		if signer, err := report.Signer(); err != nil || signer != reporter {
+			t.Fatalf("signer %s, %v", signer.Hex(), err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "reports.json")
+	if err := publisher.WriteReports(path, reports); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := publisher.LoadReports(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := publisher.Relay(ctx, logger, chain.Client, profile, loaded)
+	if status.Err != nil || status.Sent != 2 || status.Failed != 0 {
+		t.Fatalf("relay = %+v", status)
+	}
+	day, err := chain.Oracle.GetDay(chain.CallOpts(chain.Admin.From), big.NewInt(1704240000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := day.Feed
+	if stored.Value.Int64() != 43740 || stored.Updatedat.Int64() != published.Add(24*time.Hour).Unix() || stored.Confidence != 90 {
+		t.Fatalf("stored %+v", stored)
+	}
+
+	// relaying again skips the applied reports
+	status = publisher.Relay(ctx, logger, chain.Client, profile, loaded)
+	if status.Err != nil || status.Sent != 0 || status.Failed != 0 {
+		t.Fatalf("second relay = %+v", status)
+	}
+
+	// a direct replay is rejected on-chain
+	_, relayer := chain.NewAccount(t)
+	report := reports[0]
+	_, err = chain.Oracle.SubmitSignedIndicator(relayer, report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline, report.Signature)
+	simtest.RequireRevert(t, err, "ReportAlreadySubmitted")
+
+	// a tampered value recovers another address
+	_, err = chain.Oracle.SubmitSignedIndicator(relayer, report.Timestamp, big.NewInt(99999), report.UpdatedAt, report.Confidence, report.Deadline, report.Signature)
+	simtest.RequireRevert(t, err, "UnauthorizedReporter")
+	tampered := report
+	tampered.Value = big.NewInt(99999)
+	if status := publisher.Relay(ctx, logger, chain.Client, profile, []publisher.SignedReport{tampered}); status.Failed != 1 {
+		t.Fatalf("tampered relay = %+v", status)
+	}
+}
+
+func TestSignedReportFromUnknownReporter(t *testing.T) {
+	chain := simtest.New(t, "CDI", 6)
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile := network.Profile{Name: "simulated", ChainID: simtest.ChainID, Contract: chain.Address.Hex()}
+	observations := []publisher.Observation{
+		{Date: "02/01/2024", Timestamp: big.NewInt(1704153600), Value: big.NewInt(43739), UpdatedAt: time.Unix(1704272400, 0)},
+	}
+	reports, err := publisher.SignReports(key, profile, observations, publisher.UpdatedAtSource, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := reports[0]
+	_, err = chain.Oracle.SubmitSignedIndicator(chain.Admin, report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline, report.Signature)
+	revert := simtest.RequireRevert(t, err, "UnauthorizedReporter")
+	if len(revert.Args) != 1 || revert.Args[0] != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("revert args %v", revert.Args)
+	}
+
+	short := append([]byte(nil), report.Signature[:64]...)
+	_, err = chain.Oracle.SubmitSignedIndicator(chain.Admin, report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline, short)
+	simtest.RequireRevert(t, err, "InvalidSignature")
+
+	if _, err := publisher.SignReports(key, profile, observations, publisher.UpdatedAtBlock, time.Hour); err == nil {
+		t.Fatal("block updatedat accepted for signed reports")
+	}
+}
+
+func TestSignedReportDeadlineAndOrdering(t *testing.T) {
+	chain := simtest.New(t, "CDI", 6)
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	role, err := chain.Oracle.REPORTERROLE(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := chain.Oracle.GrantRole(chain.Admin, role, crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+
+	profile := network.Profile{Name: "simulated", ChainID: simtest.ChainID, Contract: chain.Address.Hex()}
+	sign := func(updatedAt int64, validity time.Duration) publisher.SignedReport {
+		t.Helper()
+		observations := []publisher.Observation{
+			{Date: "02/01/2024", Timestamp: big.NewInt(1704153600), Value: big.NewInt(43739), UpdatedAt: time.Unix(updatedAt, 0)},
+		}
+		reports, err := publisher.SignReports(key, profile, observations, publisher.UpdatedAtSource, validity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reports[0]
+	}
+	submit := func(report publisher.SignedReport) error {
+		tx, err := chain.Oracle.SubmitSignedIndicator(chain.Admin, report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline, report.Signature)
+		if err == nil {
+			chain.Mine(t, tx)
+		}
+		return err
+	}
+
+	if _, err := publisher.SignReports(key, profile, nil, publisher.UpdatedAtSource, 0); err == nil {
+		t.Fatal("reports without validity accepted")
+	}
+
+	// a correction signed later wins over the original, which can no longer replace it
+	original, correction := sign(1704272400, time.Hour), sign(1704276000, time.Hour)
+	if err := submit(correction); err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	revert := simtest.RequireRevert(t, submit(original), "StaleReport")
+	if len(revert.Args) != 2 || revert.Args[1].(*big.Int).Int64() != 1704276000 {
+		t.Fatalf("revert args %v", revert.Args)
+	}
+
+	late := sign(1704279600, time.Minute)
+	if err := chain.Backend.AdjustTime(2 * time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	simtest.RequireRevert(t, submit(late), "ReportExpired")
+}
+
+func TestSignedReportVotesUnderConsensus(t *testing.T) {
+	chain := simtest.New(t, "CDI", 6)
+	tx, err := chain.Oracle.SetConsensus(chain.Admin, 2, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	role, err := chain.Oracle.REPORTERROLE(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile := network.Profile{Name: "simulated", ChainID: simtest.ChainID, Contract: chain.Address.Hex()}
+	observations := []publisher.Observation{
+		{Date: "02/01/2024", Timestamp: big.NewInt(1704153600), Value: big.NewInt(43739), UpdatedAt: time.Unix(1704272400, 0)},
+	}
+	var reports []publisher.SignedReport
+	for i := 0; i < 2; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := chain.Oracle.GrantRole(chain.Admin, role, crypto.PubkeyToAddress(key.PublicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Mine(t, tx)
+		signed, err := publisher.SignReports(key, profile, observations, publisher.UpdatedAtSource, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reports = append(reports, signed...)
+	}
+
+	for i, report := range reports {
+		tx, err := chain.Oracle.SubmitSignedIndicator(chain.Admin, report.Timestamp, report.Value, report.UpdatedAt, report.Confidence, report.Deadline, report.Signature)
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+
+		round, err := chain.Oracle.ConsensusRound(chain.CallOpts(chain.Admin.From), report.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if round.Submissions.Int64() != int64(i+1) || round.Finalized != (i == 1) {
+			t.Fatalf("after report %d: %+v", i, round)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package reporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"abi/api"
+	"abi/metrics"
+	"abi/network"
+	"abi/publisher"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Extra gas for the vote that finalizes a day: storing the value, the last
+// indicator and the product of its block of days
+const finalizeGas = 300_000
+
+// Submits the observations as the profile signer's votes for each day through
+// submitValue. Days that are already finalized or that the signer already voted
+// on are skipped, so every reporter can run the same pipeline on a schedule.
+// A vote that loses the race to the finalizing one is not counted as a failure.
+func Submit(ctx context.Context, logger *slog.Logger, backend publisher.Backend, profile network.Profile, observations []publisher.Observation, updatedAt string) publisher.Status {
+	status := publisher.Status{Network: profile.Name}
+
+	oracle, err := api.NewOracleIndicator(profile.ContractAddress(), backend)
+	if err != nil {
+		status.Err = fmt.Errorf("error initializing contract: %v", err)
+		return status
+	}
+	auth, err := profile.Transactor()
+	if err != nil {
+		status.Err = err
+		return status
+	}
+	auth.Context = ctx
+	opts := &bind.CallOpts{Context: ctx}
+
+	quorum, err := oracle.Quorum(opts)
+	if err != nil {
+		status.Err = fmt.Errorf("failed to read quorum: %v", err)
+		return status
+	}
+	if quorum == 0 {
+		status.Err = errors.New("consensus is disabled on the contract (quorum 0)")
+		return status
+	}
+	role, err := oracle.REPORTERROLE(opts)
+	if err == nil {
+		var has bool
+		if has, err = oracle.HasRole(opts, role, auth.From); err == nil && !has {
+			err = fmt.Errorf("%s does not hold REPORTER_ROLE", auth.From.Hex())
+		}
+	}
+	if err != nil {
+		status.Err = err
+		return status
+	}
+
+	for _, obs := range observations {
+		obsLog := logger.With("date", obs.Date, "value", obs.Value.String())
+
+		round, err := oracle.ConsensusRound(opts, obs.Timestamp)
+		if err != nil {
+			status.Err = fmt.Errorf("failed to read consensus round: %v", err)
+			return status
+		}
+		if round.Finalized {
+			obsLog.Debug("Day already finalized", "stage", "submit")
+			continue
+		}
+		voted, err := oracle.HasSubmitted(opts, obs.Timestamp, auth.From)
+		if err != nil {
+			status.Err = fmt.Errorf("failed to read submissions: %v", err)
+			return status
+		}
+		if voted {
+			obsLog.Debug("Already submitted", "stage", "submit", "submissions", round.Submissions)
+			continue
+		}
+
+		stamp := big.NewInt(obs.UpdatedAt.Unix())
+		if updatedAt == publisher.UpdatedAtNow {
+			stamp = big.NewInt(time.Now().Unix())
+		} else if updatedAt == publisher.UpdatedAtBlock {
+			header, err := backend.HeaderByNumber(ctx, nil)
+			if err != nil {
+				obsLog.Error("Failed to resolve updatedat", "stage", "submit", "mode", updatedAt, "err", err)
+				status.Failed++
+				continue
+			}
+			stamp = new(big.Int).SetUint64(header.Time)
+		}
+
+		tx, err := submitValue(ctx, backend, auth, profile.ContractAddress(), oracle, obs.Timestamp, obs.Value, stamp)
+		if err != nil {
+			err = api.DecodeError(err)
+			if late(err) {
+				obsLog.Info("Day finalized before this vote", "stage", "submit")
+				continue
+			}
+			obsLog.Error("Failed to submit value", "stage", "submit", "err", err)
+			metrics.Transactions.WithLabelValues(profile.Name, "failed").Inc()
+			status.Failed++
+			continue
+		}
+		metrics.Transactions.WithLabelValues(profile.Name, "sent").Inc()
+		obsLog = obsLog.With("tx", tx.Hash().Hex(), "nonce", tx.Nonce())
+		obsLog.Info("Value submitted", "stage", "submit")
+
+		receipt, err := publisher.WaitForReceipt(ctx, backend, tx.Hash())
+		if err != nil {
+			obsLog.Error("Failed to get transaction receipt", "stage", "confirm", "err", err)
+			status.Failed++
+			continue
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			if round, err := oracle.ConsensusRound(opts, obs.Timestamp); err == nil && round.Finalized {
+				obsLog.Info("Day finalized before this vote", "stage", "confirm")
+				continue
+			}
+			obsLog.Error("Transaction reverted", "stage", "confirm", "reason", api.ReplayRevert(ctx, backend, tx, receipt))
+			status.Failed++
+			continue
+		}
+		status.Sent++
+		obsLog.Info("Value confirmed", "stage", "confirm", "block", receipt.BlockNumber.Uint64(), "gas", receipt.GasUsed)
+	}
+	return status
+}
+
+// Sends submitValue with room for finalizing the day. The gas estimate only
+// covers the state when it is taken; if other votes land first, this vote may be
+// the one that reaches the quorum and also stores the day and its block product.
+func submitValue(ctx context.Context, backend publisher.Backend, auth *bind.TransactOpts, to common.Address, oracle *api.OracleIndicator, timestamp, value, updatedAt *big.Int) (*types.Transaction, error) {
+	opts := *auth
+	if opts.GasLimit == 0 {
+		parsed, err := api.OracleIndicatorMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err := parsed.Pack("submitValue", timestamp, value, updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		gas, err := backend.EstimateGas(ctx, ethereum.CallMsg{From: auth.From, To: &to, Data: data})
+		if err != nil {
+			return nil, err
+		}
+		opts.GasLimit = gas + finalizeGas
+	}
+	return oracle.SubmitValue(&opts, timestamp, value, updatedAt)
+}
+
+// Whether the vote was rejected because another one already finalized the day
+func late(err error) bool {
+	var revert *api.RevertError
+	return errors.As(err, &revert) && revert.Name == "DayAlreadyFinalized"
+}
@@ -0,0 +1,151 @@
+package reporter
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"abi/bcbtest"
+	source "abi/json"
+	"abi/network"
+	"abi/publisher"
+	"abi/simtest"
+	"abi/validate"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Runs the fetch, validate and scale pipeline against the fake SGS API
+func observations(t *testing.T, server *bcbtest.Server) []publisher.Observation {
+	t.Helper()
+	fetcher := source.NewFetcher()
+	fetcher.Cache = source.NewCache(t.TempDir())
+	start := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	series, err := fetcher.FetchSeries(context.Background(), server.WindowURL(12, start, start.AddDate(0, 0, 6)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	accepted, report := validate.Validate(series.Data, validate.Rules{})
+	if err := report.Check(0); err != nil {
+		t.Fatal(err)
+	}
+	return publisher.Scale(slog.New(slog.NewTextHandler(io.Discard, nil)), accepted)
+}
+
+func TestReportersReachConsensus(t *testing.T) {
+	publisher.ReceiptPollInterval = 10 * time.Millisecond
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	chain := simtest.New(t, "CDI", 6)
+	tx, err := chain.Oracle.SetConsensus(chain.Admin, 2, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	role, err := chain.Oracle.REPORTERROLE(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := bcbtest.NewServer()
+	t.Cleanup(server.Close)
+	honest := observations(t, server)
+	if len(honest) < 3 {
+		t.Fatalf("fixture window has %d observations", len(honest))
+	}
+
+	// the third reporter reads a feed 5% off on every day
+	faulty := make([]publisher.Observation, len(honest))
+	for i, obs := range honest {
+		obs.Value = new(big.Int).Div(new(big.Int).Mul(obs.Value, big.NewInt(105)), big.NewInt(100))
+		faulty[i] = obs
+	}
+	feeds := [][]publisher.Observation{honest, honest, faulty}
+
+	var profiles []network.Profile
+	for i := range feeds {
+		key, auth := chain.NewAccount(t)
+		tx, err := chain.Oracle.GrantRole(chain.Admin, role, auth.From)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Mine(t, tx)
+
+		env := fmt.Sprintf("REPORTER_TEST_KEY_%d", i)
+		t.Setenv(env, hex.EncodeToString(crypto.FromECDSA(key)))
+		profiles = append(profiles, network.Profile{
+			Name:      fmt.Sprintf("reporter-%d", i),
+			ChainID:   simtest.ChainID,
+			Contract:  chain.Address.Hex(),
+			SignerEnv: env,
+		})
+	}
+	chain.AutoMine(t, 20*time.Millisecond)
+
+	statuses := make([]publisher.Status, len(feeds))
+	var wg sync.WaitGroup
+	for i := range feeds {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			statuses[i] = Submit(ctx, logger, chain.Client, profiles[i], feeds[i], publisher.UpdatedAtSource)
+		}(i)
+	}
+	wg.Wait()
+	for _, status := range statuses {
+		if status.Err != nil || status.Failed != 0 {
+			t.Fatalf("%s: %+v", status.Network, status)
+		}
+	}
+
+	opts := chain.CallOpts(chain.Admin.From)
+	for _, obs := range honest {
+		round, err := chain.Oracle.ConsensusRound(opts, obs.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stored, err := chain.Oracle.GetDay(opts, obs.Timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !round.Finalized || stored.Feed.Value.Cmp(obs.Value) != 0 {
+			t.Errorf("%s: finalized %v with %v, want %v", obs.Date, round.Finalized, stored.Feed.Value, obs.Value)
+		}
+	}
+
+	// a rerun finds every day finalized and sends nothing
+	status := Submit(ctx, logger, chain.Client, profiles[0], honest, publisher.UpdatedAtSource)
+	if status.Err != nil || status.Sent != 0 || status.Failed != 0 {
+		t.Fatalf("rerun: %+v", status)
+	}
+}
+
+func TestSubmitNeedsConsensusAndRole(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	chain := simtest.New(t, "CDI", 6)
+	key, _ := chain.NewAccount(t)
+	t.Setenv("REPORTER_TEST_KEY", hex.EncodeToString(crypto.FromECDSA(key)))
+	profile := network.Profile{Name: "simulated", ChainID: simtest.ChainID, Contract: chain.Address.Hex(), SignerEnv: "REPORTER_TEST_KEY"}
+
+	status := Submit(context.Background(), logger, chain.Client, profile, nil, publisher.UpdatedAtSource)
+	if status.Err == nil || status.Err.Error() != "consensus is disabled on the contract (quorum 0)" {
+		t.Fatalf("expected disabled consensus, got %v", status.Err)
+	}
+
+	tx, err := chain.Oracle.SetConsensus(chain.Admin, 2, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	status = Submit(context.Background(), logger, chain.Client, profile, nil, publisher.UpdatedAtSource)
+	if status.Err == nil {
+		t.Fatal("expected missing REPORTER_ROLE")
+	}
+}
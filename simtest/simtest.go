@@ -0,0 +1,178 @@
+package simtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"abi/api"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Chain ID of the simulated backend
+const ChainID = 1337
+
+// Balance given to the admin at genesis and to every account it funds later
+var (
+	adminFunding = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))
+	funding      = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
+)
+
+// Simulated chain, with a deployed OracleIndicator when created by New
+type Chain struct {
+	Backend  *simulated.Backend
+	Client   simulated.Client
+	AdminKey *ecdsa.PrivateKey
+	Admin    *bind.TransactOpts
+	Address  common.Address
+	Oracle   *api.OracleIndicator
+
+	mu sync.Mutex // the backend does not support concurrent commits
+}
+
+// Starts a chain with a funded admin and deploys the contract with it.
+// The backend is closed when the test finishes.
+func New(t testing.TB, name string, decimals uint8) *Chain {
+	t.Helper()
+
+	chain := NewChain(t)
+	address, tx, oracle, err := api.DeployOracleIndicator(chain.Admin, chain.Client, name, decimals, chain.Admin.From)
+	if err != nil {
+		t.Fatalf("deploy: %v", err)
+	}
+	chain.Mine(t, tx)
+	chain.Address = address
+	chain.Oracle = oracle
+	return chain
+}
+
+// Starts a chain with a funded admin and no contract
+func NewChain(t testing.TB) *Chain {
+	t.Helper()
+
+	adminKey, admin := newAccount(t)
+	alloc := types.GenesisAlloc{admin.From: {Balance: adminFunding}}
+	backend := simulated.NewBackend(alloc)
+	t.Cleanup(func() { backend.Close() })
+
+	chain := &Chain{Backend: backend, Client: backend.Client(), AdminKey: adminKey, Admin: admin}
+	// the genesis block is pre-merge and rejects PUSH0
+	chain.Commit()
+	return chain
+}
+
+// Creates an account funded by the admin
+func (c *Chain) NewAccount(t testing.TB) (*ecdsa.PrivateKey, *bind.TransactOpts) {
+	t.Helper()
+
+	key, auth := newAccount(t)
+	ctx := context.Background()
+	nonce, err := c.Client.PendingNonceAt(ctx, c.Admin.From)
+	if err != nil {
+		t.Fatalf("nonce: %v", err)
+	}
+	gasPrice, err := c.Client.SuggestGasPrice(ctx)
+	if err != nil {
+		t.Fatalf("gas price: %v", err)
+	}
+	tx := types.NewTransaction(nonce, auth.From, funding, params.TxGas, gasPrice, nil)
+	signed, err := c.Admin.Signer(c.Admin.From, tx)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if err := c.Client.SendTransaction(ctx, signed); err != nil {
+		t.Fatalf("fund account: %v", err)
+	}
+	c.Mine(t, signed)
+	return key, auth
+}
+
+// Mines a block
+func (c *Chain) Commit() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Backend.Commit()
+}
+
+// Mines a block and fails the test unless the transaction succeeded in it
+func (c *Chain) Mine(t testing.TB, tx *types.Transaction) *types.Receipt {
+	t.Helper()
+
+	c.Commit()
+	receipt, err := c.Client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatalf("receipt for %s: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("transaction %s reverted", tx.Hash().Hex())
+	}
+	return receipt
+}
+
+// Mines a block every interval until the test finishes, for code that waits on receipts
+func (c *Chain) AutoMine(t testing.TB, interval time.Duration) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				c.Commit()
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		close(done)
+		<-stopped
+	})
+}
+
+// Call options sent from the given account
+func (c *Chain) CallOpts(from common.Address) *bind.CallOpts {
+	return &bind.CallOpts{From: from, Context: context.Background()}
+}
+
+func newAccount(t testing.TB) (*ecdsa.PrivateKey, *bind.TransactOpts) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(ChainID))
+	if err != nil {
+		t.Fatalf("transactor: %v", err)
+	}
+	return key, auth
+}
+
+// Fails the test unless err is a revert with the named custom error, and returns it
+func RequireRevert(t testing.TB, err error, name string) *api.RevertError {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected %s revert, call succeeded", name)
+	}
+	var revert *api.RevertError
+	if !errors.As(api.DecodeError(err), &revert) {
+		t.Fatalf("expected %s revert, got %v", name, err)
+	}
+	if revert.Name != name {
+		t.Fatalf("expected %s revert, got %s", name, revert.Reason)
+	}
+	return revert
+}
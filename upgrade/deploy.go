@@ -0,0 +1,138 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"abi/api"
+	"abi/bindgen"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Node access needed to deploy and upgrade
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Addresses of an upgradeable deployment
+type Deployment struct {
+	Proxy          common.Address
+	Implementation common.Address
+}
+
+// Deploys an OracleIndicatorV2 implementation and a proxy initialized with
+// the series name, decimals and admin. The proxy address is the one to publish to.
+func Deploy(ctx context.Context, auth *bind.TransactOpts, backend Backend, name string, decimals uint8, admin common.Address) (*Deployment, error) {
+	implementation, tx, _, err := api.DeployOracleIndicatorV2(auth, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy implementation: %w", api.DecodeError(err))
+	}
+	if _, err := bind.WaitDeployed(ctx, backend, tx); err != nil {
+		return nil, fmt.Errorf("implementation %s: %v", tx.Hash().Hex(), err)
+	}
+
+	parsed, err := api.OracleIndicatorV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	initialize, err := parsed.Pack("initialize", name, decimals, admin)
+	if err != nil {
+		return nil, err
+	}
+	proxy, tx, _, err := api.DeployOracleIndicatorProxy(auth, backend, implementation, initialize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy proxy: %w", api.DecodeError(err))
+	}
+	if _, err := bind.WaitDeployed(ctx, backend, tx); err != nil {
+		return nil, fmt.Errorf("proxy %s: %v", tx.Hash().Hex(), err)
+	}
+
+	return &Deployment{Proxy: proxy, Implementation: implementation}, nil
+}
+
+// Deploys the artifact as a new implementation and points the proxy at it,
+// calling the artifact's argumentless function named call in the same
+// transaction unless call is empty (e.g. initializeV2 when leaving V1).
+// The caller checks storage compatibility first with CheckLayout.
+func Upgrade(ctx context.Context, auth *bind.TransactOpts, backend Backend, proxy common.Address, artifact *bindgen.Artifact, call string) (common.Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(string(artifact.ABI)))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid %s ABI: %v", artifact.Name, err)
+	}
+	var data []byte
+	if call != "" {
+		if data, err = parsed.Pack(call); err != nil {
+			return common.Address{}, fmt.Errorf("%s.%s: %v", artifact.Name, call, err)
+		}
+	}
+	implementation, tx, _, err := bind.DeployContract(auth, parsed, common.FromHex(artifact.Bin), backend)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", artifact.Name, api.DecodeError(err))
+	}
+	if _, err := bind.WaitDeployed(ctx, backend, tx); err != nil {
+		return common.Address{}, fmt.Errorf("implementation %s: %v", tx.Hash().Hex(), err)
+	}
+
+	oracle, err := api.NewOracleIndicatorV1Transactor(proxy, backend)
+	if err != nil {
+		return common.Address{}, err
+	}
+	tx, err = oracle.UpgradeToAndCall(auth, implementation, data)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("upgradeToAndCall: %w", api.DecodeError(err))
+	}
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return common.Address{}, fmt.Errorf("upgradeToAndCall %s reverted", tx.Hash().Hex())
+	}
+	return implementation, nil
+}
+
+// Converts the checkpoints a V1 proxy left behind into OracleIndicatorV2's
+// per-segment products, batch days per transaction, and returns how many
+// were converted. Writes and cumulative reads revert until none is left.
+func MigrateCheckpoints(ctx context.Context, auth *bind.TransactOpts, backend Backend, proxy common.Address, batch int64) (int64, error) {
+	oracle, err := api.NewOracleIndicatorV2(proxy, backend)
+	if err != nil {
+		return 0, err
+	}
+	if batch <= 0 {
+		return 0, fmt.Errorf("batch must be positive, got %d", batch)
+	}
+	var migrated int64
+	for {
+		pending, err := oracle.PendingCheckpoints(&bind.CallOpts{Context: ctx, From: auth.From})
+		if err != nil {
+			return migrated, fmt.Errorf("failed to read pending checkpoints: %v", err)
+		}
+		if pending.Sign() == 0 {
+			return migrated, nil
+		}
+		count := batch
+		if pending.IsInt64() && pending.Int64() < count {
+			count = pending.Int64()
+		}
+		tx, err := oracle.MigrateCheckpoints(auth, big.NewInt(count))
+		if err != nil {
+			return migrated, fmt.Errorf("migrateCheckpoints: %w", api.DecodeError(err))
+		}
+		receipt, err := bind.WaitMined(ctx, backend, tx)
+		if err != nil {
+			return migrated, err
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return migrated, fmt.Errorf("migrateCheckpoints %s reverted", tx.Hash().Hex())
+		}
+		migrated += count
+	}
+}
@@ -0,0 +1,289 @@
+package upgrade
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"abi/api"
+	"abi/bindgen"
+	"abi/simtest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDeployAndUpgrade(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.NewChain(t)
+	chain.AutoMine(t, 10*time.Millisecond)
+
+	deployment, err := Deploy(ctx, chain.Admin, chain.Client, "CDI", 8, chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	implementation, err := api.Implementation(ctx, chain.Client, deployment.Proxy)
+	if err != nil || implementation != deployment.Implementation {
+		t.Fatalf("implementation = %s, %v; want %s", implementation.Hex(), err, deployment.Implementation.Hex())
+	}
+	name, version, err := api.LiveContract(ctx, chain.Client, deployment.Proxy)
+	if err != nil || name != "OracleIndicatorV2" || version != 2 {
+		t.Fatalf("live contract = %s v%d, %v", name, version, err)
+	}
+
+	role, err := api.PublisherRole(chain.CallOpts(chain.Admin.From), chain.Client, deployment.Proxy)
+	if err != nil || role != crypto.Keccak256Hash([]byte("PUBLISHER_ROLE")) {
+		t.Fatalf("publisher role of V2 = %s, %v", role.Hex(), err)
+	}
+
+	// the original binding drives the proxy unchanged
+	oracle, err := api.NewOracleIndicator(deployment.Proxy, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamp := big.NewInt(1704153600)
+	tx, err := oracle.SaveIndicator(chain.Admin, timestamp, big.NewInt(100_040_000), timestamp, 100)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+
+	proxied, err := api.NewOracleIndicatorV2(deployment.Proxy, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = proxied.Initialize(chain.Admin, "CDI", 8, chain.Admin.From)
+	simtest.RequireRevert(t, err, "InvalidInitialization")
+
+	artifact, err := bindgen.Load("../build", "OracleIndicatorV2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, outsider := chain.NewAccount(t)
+	if _, err := Upgrade(ctx, outsider, chain.Client, deployment.Proxy, artifact, ""); err == nil {
+		t.Fatal("upgrade by a non-admin succeeded")
+	} else {
+		simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+	}
+
+	upgraded, err := Upgrade(ctx, chain.Admin, chain.Client, deployment.Proxy, artifact, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	implementation, err = api.Implementation(ctx, chain.Client, deployment.Proxy)
+	if err != nil || implementation != upgraded || upgraded == deployment.Implementation {
+		t.Fatalf("implementation after upgrade = %s, %v", implementation.Hex(), err)
+	}
+
+	stored, _, err := api.ReadDay(chain.CallOpts(chain.Admin.From), chain.Client, deployment.Proxy, timestamp)
+	if err != nil || stored.Value.Int64() != 100_040_000 {
+		t.Fatalf("stored day after upgrade = %+v, %v", stored, err)
+	}
+	decimals, err := oracle.Decimal(chain.CallOpts(chain.Admin.From))
+	if err != nil || decimals != 8 {
+		t.Fatalf("decimal after upgrade = %d, %v", decimals, err)
+	}
+}
+
+// Deploys a V1 proxy the way deployments made before OracleIndicatorV2 were
+func deployV1(t *testing.T, chain *simtest.Chain) common.Address {
+	t.Helper()
+	ctx := context.Background()
+	implementation, tx, _, err := api.DeployOracleIndicatorV1(chain.Admin, chain.Client)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	if _, err := bind.WaitDeployed(ctx, chain.Client, tx); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := api.OracleIndicatorV1MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	initialize, err := parsed.Pack("initialize", "CDI", uint8(8), chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy, tx, _, err := api.DeployOracleIndicatorProxy(chain.Admin, chain.Client, implementation, initialize)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	if _, err := bind.WaitDeployed(ctx, chain.Client, tx); err != nil {
+		t.Fatal(err)
+	}
+	return proxy
+}
+
+// V1 stays as deployed: days in order, checkpoints that underflow after tiny
+// values, and a public indicators mapping. OracleIndicatorV2 fixes all three.
+func TestV1IsFrozen(t *testing.T) {
+	chain := simtest.NewChain(t)
+	chain.AutoMine(t, 10*time.Millisecond)
+	proxy := deployV1(t, chain)
+
+	oracle, err := api.NewOracleIndicatorV1(proxy, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := int64(1704153600)
+	// five days at 1e-8 take the running product below the checkpoint precision
+	for i := int64(0); i < 6; i++ {
+		value := big.NewInt(1)
+		if i == 5 {
+			value = big.NewInt(100_000_000)
+		}
+		timestamp := big.NewInt(start + i*86400)
+		tx, err := oracle.SaveIndicator(chain.Admin, timestamp, value, timestamp, 100)
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+	}
+	_, err = oracle.SaveIndicator(chain.Admin, big.NewInt(start), big.NewInt(1), big.NewInt(start), 100)
+	simtest.RequireRevert(t, err, "IndicatorOutOfOrder")
+
+	readOnly, err := oracle.READONLY(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := oracle.GrantRole(chain.Admin, readOnly, chain.Admin.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	last := big.NewInt(start + 5*86400)
+	_, err = oracle.GetCumulativeInterval(chain.CallOpts(chain.Admin.From), last, last)
+	simtest.RequireRevert(t, err, "CheckpointUnderflow")
+
+	// anyone reads a day through the mapping getter, READ_ONLY or not
+	_, outsider := chain.NewAccount(t)
+	stored, err := oracle.Indicators(chain.CallOpts(outsider.From), last)
+	if err != nil || stored.Value.Int64() != 100_000_000 {
+		t.Fatalf("day read by an outsider = %+v, %v", stored, err)
+	}
+	_, err = oracle.GetDate(chain.CallOpts(outsider.From), last)
+	simtest.RequireRevert(t, err, "AccessControlUnauthorizedAccount")
+}
+
+func TestUpgradeV1ToV2(t *testing.T) {
+	ctx := context.Background()
+	chain := simtest.NewChain(t)
+	chain.AutoMine(t, 10*time.Millisecond)
+	proxy := deployV1(t, chain)
+
+	oracle, err := api.NewOracleIndicator(proxy, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := int64(1704153600)
+	values := []int64{100_040_000, 100_050_000, 100_030_000, 100_045_000, 100_020_000}
+	for i, value := range values {
+		timestamp := big.NewInt(start + int64(i)*86400)
+		tx, err := oracle.SaveIndicator(chain.Admin, timestamp, big.NewInt(value), timestamp, 100)
+		if err != nil {
+			t.Fatal(api.DecodeError(err))
+		}
+		chain.Mine(t, tx)
+	}
+	// V1 only takes days in order; OracleIndicatorV2 lifts that
+	_, err = oracle.SaveIndicator(chain.Admin, big.NewInt(start), big.NewInt(1), big.NewInt(start), 100)
+	simtest.RequireRevert(t, err, "IndicatorOutOfOrder")
+
+	_, reader := chain.NewAccount(t)
+	readOnly, err := oracle.READONLY(chain.CallOpts(chain.Admin.From))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := oracle.GrantRole(chain.Admin, readOnly, reader.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Mine(t, tx)
+	end := big.NewInt(start + int64(len(values)-1)*86400)
+	before, err := oracle.GetCumulativeInterval(chain.CallOpts(reader.From), big.NewInt(start), end)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+
+	artifact, err := bindgen.Load("../build", "OracleIndicatorV2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Upgrade(ctx, chain.Admin, chain.Client, proxy, artifact, "initializeV2"); err != nil {
+		t.Fatal(err)
+	}
+	name, version, err := api.LiveContract(ctx, chain.Client, proxy)
+	if err != nil || name != "OracleIndicatorV2" || version != 2 {
+		t.Fatalf("live contract = %s v%d, %v", name, version, err)
+	}
+	v2, err := api.NewOracleIndicatorV2(proxy, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = v2.InitializeV2(chain.Admin)
+	simtest.RequireRevert(t, err, "InvalidInitialization")
+
+	// the admin that upgraded publishes and guards, as on a fresh deployment
+	role, err := api.PublisherRole(chain.CallOpts(chain.Admin.From), chain.Client, proxy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publisher, err := oracle.HasRole(chain.CallOpts(chain.Admin.From), role, chain.Admin.From)
+	if err != nil || !publisher {
+		t.Fatalf("admin holds PUBLISHER_ROLE = %v, %v", publisher, err)
+	}
+
+	// writes and cumulative reads wait for the checkpoints to be converted
+	_, err = oracle.SaveIndicator(chain.Admin, big.NewInt(start), big.NewInt(1), big.NewInt(start), 100)
+	simtest.RequireRevert(t, err, "MigrationPending")
+	_, err = oracle.GetCumulativeInterval(chain.CallOpts(reader.From), big.NewInt(start), end)
+	simtest.RequireRevert(t, err, "MigrationPending")
+	last, err := oracle.GetLast(chain.CallOpts(reader.From))
+	if err != nil || last.Value.Int64() != values[len(values)-1] {
+		t.Fatalf("last during migration = %+v, %v", last, err)
+	}
+
+	migrated, err := MigrateCheckpoints(ctx, chain.Admin, chain.Client, proxy, 2)
+	if err != nil || migrated != int64(len(values)) {
+		t.Fatalf("migrated %d checkpoints, %v", migrated, err)
+	}
+	after, err := oracle.GetCumulativeInterval(chain.CallOpts(reader.From), big.NewInt(start), end)
+	if err != nil || after.Cmp(before) != 0 {
+		t.Fatalf("cumulative interval after migration = %s, %v; want %s", after, err, before)
+	}
+	count, err := oracle.CheckpointCount(chain.CallOpts(reader.From))
+	if err != nil || count.Int64() != int64(len(values)) {
+		t.Fatalf("stored days after migration = %s, %v", count, err)
+	}
+	lastDay, err := oracle.LastDay(chain.CallOpts(reader.From))
+	if err != nil || lastDay.Cmp(end) != 0 {
+		t.Fatalf("last day after migration = %s, %v", lastDay, err)
+	}
+
+	// a correction of a migrated day now goes through and moves the product
+	timestamp := big.NewInt(start + 86400)
+	tx, err = oracle.SaveIndicator(chain.Admin, timestamp, big.NewInt(100_060_000), timestamp, 100)
+	if err != nil {
+		t.Fatal(api.DecodeError(err))
+	}
+	chain.Mine(t, tx)
+	corrected, err := oracle.GetCumulativeInterval(chain.CallOpts(reader.From), big.NewInt(start), end)
+	if err != nil || corrected.Cmp(before) <= 0 {
+		t.Fatalf("cumulative interval after correction = %s, %v; want above %s", corrected, err, before)
+	}
+}
+
+func TestLiveVersionOfPlainContract(t *testing.T) {
+	chain := simtest.New(t, "CDI", 8)
+	name, version, err := api.LiveContract(context.Background(), chain.Client, chain.Address)
+	if err != nil || name != "OracleIndicator" || version != 0 {
+		t.Fatalf("live contract = %s v%d, %v", name, version, err)
+	}
+	implementation, err := api.Implementation(context.Background(), chain.Client, chain.Address)
+	if err != nil || implementation != (common.Address{}) {
+		t.Fatalf("implementation of a plain contract = %s, %v", implementation.Hex(), err)
+	}
+}
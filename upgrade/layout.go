@@ -0,0 +1,179 @@
+package upgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"abi/api"
+	"abi/bindgen"
+)
+
+// Storage layout as emitted by solc's storageLayout output
+type Layout struct {
+	Storage []Variable             `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// State variable or struct member
+type Variable struct {
+	Label  string `json:"label"`
+	Offset int    `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// Entry of the layout type table
+type StorageType struct {
+	Encoding      string     `json:"encoding"` // inplace, mapping, dynamic_array or bytes
+	Label         string     `json:"label"`
+	NumberOfBytes string     `json:"numberOfBytes"`
+	Members       []Variable `json:"members"`
+	Key           string     `json:"key"`
+	Value         string     `json:"value"`
+	Base          string     `json:"base"`
+}
+
+// Reads a build/<Name>.layout.json file
+func LoadLayout(path string) (*Layout, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var layout Layout
+	if err := json.Unmarshal(body, &layout); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return &layout, nil
+}
+
+// Lists the changes that would corrupt existing storage when next replaces prev
+// behind a proxy. Variables may only be appended; existing ones must keep their
+// name, slot, offset and type. Structs stored in mappings or dynamic arrays may
+// gain members at the end, since each element owns its own slots.
+func CheckLayout(prev, next *Layout) []string {
+	var problems []string
+	for i, old := range prev.Storage {
+		if i >= len(next.Storage) {
+			problems = append(problems, fmt.Sprintf("%s (slot %s) was removed", old.Label, old.Slot))
+			continue
+		}
+		problems = append(problems, compareVariable(old.Label, old, next.Storage[i], prev, next)...)
+	}
+	if len(next.Storage) > len(prev.Storage) && len(prev.Storage) > 0 {
+		last := prev.Storage[len(prev.Storage)-1]
+		end := slotNumber(last.Slot) + slots(prev, last.Type)
+		for _, added := range next.Storage[len(prev.Storage):] {
+			if slotNumber(added.Slot) < end {
+				problems = append(problems, fmt.Sprintf("new variable %s (slot %s) overlaps existing storage", added.Label, added.Slot))
+			}
+		}
+	}
+	return problems
+}
+
+// Checks every upgradeable version in api.Versions against the one before it,
+// using the pinned layouts in buildDir. Version 0 is not behind a proxy.
+func CheckVersions(buildDir string) ([]string, error) {
+	var problems []string
+	for version := uint64(2); ; version++ {
+		to, ok := api.Versions[version]
+		if !ok {
+			return problems, nil
+		}
+		from := api.Versions[version-1]
+		prev, err := LoadLayout(bindgen.LayoutPath(buildDir, from))
+		if err != nil {
+			return nil, err
+		}
+		next, err := LoadLayout(bindgen.LayoutPath(buildDir, to))
+		if err != nil {
+			return nil, err
+		}
+		for _, problem := range CheckLayout(prev, next) {
+			problems = append(problems, fmt.Sprintf("%s -> %s: %s", from, to, problem))
+		}
+	}
+}
+
+func compareVariable(path string, old, new Variable, prev, next *Layout) []string {
+	if old.Label != new.Label {
+		return []string{fmt.Sprintf("%s: slot %s offset %d now holds %s", path, old.Slot, old.Offset, new.Label)}
+	}
+	if old.Slot != new.Slot || old.Offset != new.Offset {
+		return []string{fmt.Sprintf("%s moved from slot %s offset %d to slot %s offset %d", path, old.Slot, old.Offset, new.Slot, new.Offset)}
+	}
+	return compareType(path, old.Type, new.Type, prev, next, false)
+}
+
+// Types are compared structurally: their ids embed AST ids and contract names,
+// which change between compilations even when the layout does not
+func compareType(path, oldID, newID string, prev, next *Layout, growable bool) []string {
+	old, ok := prev.Types[oldID]
+	if !ok {
+		return []string{fmt.Sprintf("%s: type %s missing from previous layout", path, oldID)}
+	}
+	new, ok := next.Types[newID]
+	if !ok {
+		return []string{fmt.Sprintf("%s: type %s missing from new layout", path, newID)}
+	}
+	if old.Encoding != new.Encoding {
+		return []string{fmt.Sprintf("%s changed from %s to %s", path, old.Label, new.Label)}
+	}
+
+	switch old.Encoding {
+	case "mapping":
+		problems := compareType(path+" key", old.Key, new.Key, prev, next, false)
+		return append(problems, compareType(path+" value", old.Value, new.Value, prev, next, true)...)
+	case "dynamic_array":
+		return compareType(path+"[]", old.Base, new.Base, prev, next, true)
+	}
+
+	if (old.Members == nil) != (new.Members == nil) {
+		return []string{fmt.Sprintf("%s changed from %s to %s", path, old.Label, new.Label)}
+	}
+	if old.Members == nil {
+		if typeName(old.Label) != typeName(new.Label) || old.NumberOfBytes != new.NumberOfBytes {
+			return []string{fmt.Sprintf("%s changed from %s to %s", path, old.Label, new.Label)}
+		}
+		return nil
+	}
+
+	if len(new.Members) < len(old.Members) {
+		return []string{fmt.Sprintf("%s lost members", path)}
+	}
+	if !growable && old.NumberOfBytes != new.NumberOfBytes {
+		return []string{fmt.Sprintf("%s changed size from %s to %s bytes", path, old.NumberOfBytes, new.NumberOfBytes)}
+	}
+	var problems []string
+	for i, member := range old.Members {
+		problems = append(problems, compareVariable(path+"."+member.Label, member, new.Members[i], prev, next)...)
+	}
+	return problems
+}
+
+// Drops the declaring contract from labels such as "struct OracleIndicatorV1.DataFeed"
+func typeName(label string) string {
+	kind, name, ok := strings.Cut(label, " ")
+	if !ok {
+		return label
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return kind + " " + name
+}
+
+func slotNumber(slot string) uint64 {
+	var n uint64
+	fmt.Sscan(slot, &n)
+	return n
+}
+
+// Number of slots a type occupies in place
+func slots(layout *Layout, typeID string) uint64 {
+	var size uint64
+	fmt.Sscan(layout.Types[typeID].NumberOfBytes, &size)
+	return (size + 31) / 32
+}
@@ -0,0 +1,112 @@
+package upgrade
+
+import (
+	"strings"
+	"testing"
+
+	"abi/bindgen"
+)
+
+func loadV1(t *testing.T) *Layout {
+	t.Helper()
+	layout, err := LoadLayout(bindgen.LayoutPath("../build", "OracleIndicatorV1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return layout
+}
+
+// Finds the type id of a variable or struct by label suffix
+func typeID(t *testing.T, layout *Layout, label string) string {
+	t.Helper()
+	for id, typ := range layout.Types {
+		if strings.HasSuffix(typ.Label, label) {
+			return id
+		}
+	}
+	t.Fatalf("no type labelled %s", label)
+	return ""
+}
+
+// Every pinned implementation must be a valid upgrade of the version before it
+func TestVersionsAreUpgradeable(t *testing.T) {
+	problems, err := CheckVersions("../build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, problem := range problems {
+		t.Error(problem)
+	}
+}
+
+func TestCheckLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(t *testing.T, l *Layout)
+		want   string // substring of the first problem, empty when compatible
+	}{
+		{"identical", func(t *testing.T, l *Layout) {}, ""},
+		{"appended variable", func(t *testing.T, l *Layout) {
+			l.Storage = append(l.Storage, Variable{Label: "paused", Slot: "7", Type: "t_bool"})
+			l.Types["t_bool"] = StorageType{Encoding: "inplace", Label: "bool", NumberOfBytes: "1"}
+		}, ""},
+		{"renamed contract", func(t *testing.T, l *Layout) {
+			id := typeID(t, l, ".DataFeed")
+			typ := l.Types[id]
+			typ.Label = "struct OracleIndicatorV2.DataFeed"
+			l.Types[id] = typ
+		}, ""},
+		{"member appended to mapped struct", func(t *testing.T, l *Layout) {
+			// Checkpoint only lives in the dynamic array, so each element can grow
+			id := typeID(t, l, ".Checkpoint")
+			typ := l.Types[id]
+			typ.Members = append(append([]Variable(nil), typ.Members...), Variable{Label: "count", Slot: "2", Type: "t_uint256"})
+			typ.NumberOfBytes = "96"
+			l.Types[id] = typ
+		}, ""},
+		{"member appended to inline struct", func(t *testing.T, l *Layout) {
+			id := typeID(t, l, ".DataFeed")
+			typ := l.Types[id]
+			typ.Members = append(append([]Variable(nil), typ.Members...), Variable{Label: "source", Slot: "3", Type: "t_uint256"})
+			typ.NumberOfBytes = "128"
+			l.Types[id] = typ
+		}, "lastIndicator changed size"},
+		{"removed variable", func(t *testing.T, l *Layout) {
+			l.Storage = l.Storage[:len(l.Storage)-1]
+		}, "checkpoints (slot 6) was removed"},
+		{"reordered variables", func(t *testing.T, l *Layout) {
+			l.Storage[0], l.Storage[1] = l.Storage[1], l.Storage[0]
+		}, "decimals: slot 0 offset 0 now holds name"},
+		{"changed type", func(t *testing.T, l *Layout) {
+			l.Storage[0].Type = "t_uint256"
+		}, "decimals changed from uint8 to uint256"},
+		{"changed mapping value", func(t *testing.T, l *Layout) {
+			l.Storage[2].Type = "t_mapping(t_uint256,t_int256)"
+			l.Types["t_mapping(t_uint256,t_int256)"] = StorageType{Encoding: "mapping", Label: "mapping(uint256 => int256)", NumberOfBytes: "32", Key: "t_uint256", Value: "t_int256"}
+		}, "indicators value changed"},
+		{"inserted variable", func(t *testing.T, l *Layout) {
+			inserted := Variable{Label: "paused", Slot: "1", Offset: 1, Type: "t_uint8"}
+			l.Storage = append([]Variable{l.Storage[0], inserted}, l.Storage[1:]...)
+		}, "slot 1 offset 0 now holds paused"},
+		{"overlapping append", func(t *testing.T, l *Layout) {
+			l.Storage = append(l.Storage, Variable{Label: "paused", Slot: "6", Offset: 0, Type: "t_uint8"})
+		}, "overlaps existing storage"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := loadV1(t)
+			next := loadV1(t)
+			tt.mutate(t, next)
+			problems := CheckLayout(prev, next)
+			if tt.want == "" {
+				if len(problems) > 0 {
+					t.Fatalf("unexpected problems: %v", problems)
+				}
+				return
+			}
+			if len(problems) == 0 || !strings.Contains(problems[0], tt.want) {
+				t.Fatalf("problems = %v, want %q", problems, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"abi/datekey"
+	source "abi/json"
+)
+
+var (
+	datePattern  = regexp.MustCompile(`^\d{2}/\d{2}/\d{4}$`)
+	valuePattern = regexp.MustCompile(`^-?\d+([.,]\d+)?$`)
+)
+
+// Limits applied to a fetched series. Nil bounds and a zero MaxJump disable the check.
+type Rules struct {
+	MinValue *float64
+	MaxValue *float64
+	MaxJump  float64 // largest accepted relative change from the last accepted value, e.g. 0.5 for 50%
+	Now      func() time.Time
+}
+
+// Entry that passed validation
+type Observation struct {
+	Key         datekey.Key
+	Text        string
+	Value       *big.Float
+	PublishedAt time.Time // when the source first published the entry
+}
+
+// Entry rejected by a check
+type Issue struct {
+	Index  int    `json:"index"`
+	Date   string `json:"date"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// Outcome of validating one run
+type Report struct {
+	Total      int     `json:"total"`
+	Accepted   int     `json:"accepted"`
+	Normalized int     `json:"normalized"`
+	Rejected   []Issue `json:"rejected"`
+}
+
+// Checks every entry and returns the accepted ones in order together with the report
+func Validate(data []source.Data, rules Rules) ([]Observation, *Report) {
+	now := time.Now
+	if rules.Now != nil {
+		now = rules.Now
+	}
+	today := datekey.FromTime(now())
+
+	report := &Report{Total: len(data)}
+	seen := make(map[string]bool)
+	var accepted []Observation
+	var last *Observation
+	// previous entry that got as far as the jump check, accepted or not
+	var previous *float64
+
+	for i, entry := range data {
+		reject := func(format string, args ...interface{}) {
+			report.Rejected = append(report.Rejected, Issue{Index: i, Date: entry.Data, Value: entry.Valor, Reason: fmt.Sprintf(format, args...)})
+		}
+
+		if !datePattern.MatchString(entry.Data) {
+			reject("date is not in dd/mm/yyyy format")
+			continue
+		}
+		key, err := datekey.Parse(entry.Data)
+		if err != nil {
+			reject("invalid date: %v", err)
+			continue
+		}
+		if seen[entry.Data] {
+			reject("duplicate date")
+			continue
+		}
+		seen[entry.Data] = true
+		if key > today {
+			reject("date is in the future")
+			continue
+		}
+		if last != nil && key <= last.Key {
+			reject("date is not after %s", last.Text)
+			continue
+		}
+
+		text := strings.TrimSpace(entry.Valor)
+		if text == "" {
+			reject("empty value")
+			continue
+		}
+		if !valuePattern.MatchString(text) {
+			reject("value is not a decimal number")
+			continue
+		}
+		if strings.Contains(text, ",") {
+			text = strings.Replace(text, ",", ".", 1)
+			report.Normalized++
+		}
+		value, ok := new(big.Float).SetString(text)
+		if !ok {
+			reject("value is not a decimal number")
+			continue
+		}
+
+		f, _ := value.Float64()
+		if rules.MinValue != nil && f < *rules.MinValue {
+			reject("value below minimum %g", *rules.MinValue)
+			continue
+		}
+		if rules.MaxValue != nil && f > *rules.MaxValue {
+			reject("value above maximum %g", *rules.MaxValue)
+			continue
+		}
+		// A jump from the last accepted value is still accepted when the
+		// previous entry was already at the new level, so a lone spike is
+		// rejected but a level shift costs a single entry
+		before := previous
+		previous = &f
+		if rules.MaxJump > 0 && last != nil {
+			prev, _ := last.Value.Float64()
+			shifted := before != nil && jump(*before, f) <= rules.MaxJump
+			if jump(prev, f) > rules.MaxJump && !shifted {
+				reject("change of %.2f%% from %s exceeds %.2f%%", 100*jump(prev, f), last.Text, 100*rules.MaxJump)
+				continue
+			}
+		}
+
+		accepted = append(accepted, Observation{Key: key, Text: entry.Data, Value: value, PublishedAt: entry.PublishedAt})
+		last = &accepted[len(accepted)-1]
+	}
+
+	report.Accepted = len(accepted)
+	return accepted, report
+}
+
+// Share of entries that were rejected
+func (r *Report) ErrorRate() float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return float64(len(r.Rejected)) / float64(r.Total)
+}
+
+// Fails when the error rate is above the threshold
+func (r *Report) Check(maxErrorRate float64) error {
+	if rate := r.ErrorRate(); rate > maxErrorRate {
+		return fmt.Errorf("%d of %d entries rejected (%.2f%%), above the %.2f%% threshold", len(r.Rejected), r.Total, 100*rate, 100*maxErrorRate)
+	}
+	return nil
+}
+
+// Human readable summary followed by every rejected entry
+func (r *Report) Write(w io.Writer) {
+	fmt.Fprintf(w, "Validation: %d entries, %d accepted, %d rejected, %d normalized\n", r.Total, r.Accepted, len(r.Rejected), r.Normalized)
+	for _, issue := range r.Rejected {
+		fmt.Fprintf(w, "  #%d %q %q: %s\n", issue.Index, issue.Date, issue.Value, issue.Reason)
+	}
+}
+
+// Machine readable report
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// Relative change from prev to value, 0 when prev is 0
+func jump(prev, value float64) float64 {
+	if prev == 0 {
+		return 0
+	}
+	return abs(value-prev) / abs(prev)
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,104 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	source "abi/json"
+	"abi/validate"
+)
+
+func now() time.Time {
+	return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func TestValidateRejects(t *testing.T) {
+	min, max := 0.0, 1.0
+	data := []source.Data{
+		{Data: "01/02/2024", Valor: "0.043739"},
+		{Data: "2024-02-02", Valor: "0.043739"},
+		{Data: "31/02/2024", Valor: "0.043739"},
+		{Data: "01/02/2024", Valor: "0.043739"},
+		{Data: "02/03/2024", Valor: "0.043739"},
+		{Data: "05/02/2024", Valor: ""},
+		{Data: "06/02/2024", Valor: "n/a"},
+		{Data: "07/02/2024", Valor: "0,043739"},
+		{Data: "04/02/2024", Valor: "0.043739"},
+		{Data: "08/02/2024", Valor: "-0.01"},
+		{Data: "09/02/2024", Valor: "1.5"},
+	}
+	accepted, report := validate.Validate(data, validate.Rules{MinValue: &min, MaxValue: &max, Now: now})
+
+	reasons := []string{
+		"dd/mm/yyyy",
+		"invalid date",
+		"duplicate date",
+		"in the future",
+		"empty value",
+		"not a decimal number",
+		"not after 07/02/2024",
+		"below minimum",
+		"above maximum",
+	}
+	if len(report.Rejected) != len(reasons) {
+		t.Fatalf("rejected %+v", report.Rejected)
+	}
+	for i, reason := range reasons {
+		if !strings.Contains(report.Rejected[i].Reason, reason) {
+			t.Errorf("rejection %d = %q, want %q", i, report.Rejected[i].Reason, reason)
+		}
+	}
+	if len(accepted) != 2 || report.Accepted != 2 || report.Normalized != 1 {
+		t.Fatalf("accepted %d, report %+v", len(accepted), report)
+	}
+	if accepted[1].Value.Text('f', 6) != "0.043739" {
+		t.Errorf("normalized value = %s", accepted[1].Value.Text('f', 6))
+	}
+	if err := report.Check(0.9); err != nil {
+		t.Error(err)
+	}
+	if err := report.Check(0.5); err == nil {
+		t.Error("error rate above the threshold was accepted")
+	}
+}
+
+func TestValidateMaxJump(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		rejected []int
+	}{
+		{"steady", []string{"10", "11", "10.5", "12"}, nil},
+		{"lone spike", []string{"10", "30", "10", "11"}, []int{1}},
+		{"spike then drop", []string{"10", "40", "4", "10"}, []int{1, 2}},
+		{"level shift", []string{"10", "10", "30", "31", "30.5", "32"}, []int{2}},
+		{"gradual climb", []string{"10", "14", "19", "26"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := make([]source.Data, len(tt.values))
+			for i, value := range tt.values {
+				day := time.Date(2024, 2, 1+i, 0, 0, 0, 0, time.UTC)
+				data[i] = source.Data{Data: day.Format("02/01/2006"), Valor: value}
+			}
+			accepted, report := validate.Validate(data, validate.Rules{MaxJump: 0.5, Now: now})
+
+			var rejected []int
+			for _, issue := range report.Rejected {
+				rejected = append(rejected, issue.Index)
+			}
+			if len(rejected) != len(tt.rejected) {
+				t.Fatalf("rejected %v, want %v", rejected, tt.rejected)
+			}
+			for i := range rejected {
+				if rejected[i] != tt.rejected[i] {
+					t.Fatalf("rejected %v, want %v", rejected, tt.rejected)
+				}
+			}
+			if len(accepted)+len(rejected) != len(tt.values) {
+				t.Errorf("%d accepted and %d rejected of %d", len(accepted), len(rejected), len(tt.values))
+			}
+		})
+	}
+}